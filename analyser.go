@@ -0,0 +1,166 @@
+package textstats
+
+import "sync"
+
+// Analyser analyses a text written to it a chunk at a time, such as text
+// being typed or log lines as they arrive. Chunks may split words, or even
+// runes, anywhere. Results returns a snapshot of the analysis so far at any
+// time without scanning the text again. An Analyser is an io.Writer, so it
+// can be used with io.Copy and io.MultiWriter, and is safe for concurrent use.
+type Analyser struct {
+	mu sync.Mutex
+
+	opts Options
+	res  *Results
+	t    *tokenizer
+
+	// written is the number of bytes of the text analysed
+	written int
+
+	// end is where the word limit was reached, after which tokens are
+	// ignored, or -1
+	end int
+
+	// err is set once the analysis has been truncated
+	err *TruncatedError
+
+	// detecting is set while the start of the text is held back in detect
+	// until there is enough of it to detect its language
+	detecting bool
+	detect    []byte
+}
+
+// NewAnalyser returns an Analyser for an empty text, enabling the optional
+// parts of the analysis selected in opts
+func NewAnalyser(opts Options) *Analyser {
+	a := &Analyser{opts: opts, res: newResults(opts), end: -1}
+	a.detecting = opts.DetectLanguage && opts.Language == nil
+	a.t = newTokenizer(opts, a.token)
+	return a
+}
+
+// Write analyses the next chunk of the text. Once the text goes over
+// Options.MaxBytes or Options.MaxWords the rest of it is ignored and Write
+// returns a *TruncatedError.
+func (a *Analyser) Write(p []byte) (int, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.write(p)
+}
+
+// Results returns a snapshot of the analysis of the text written so far, as
+// if the text ended there. Writing more text doesn't change the snapshot.
+// Taking a snapshot copies the analysis, so costs time in proportion to the
+// number of distinct words and phrases tracked.
+func (a *Analyser) Results() *Results {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	snap := &Analyser{
+		opts:    a.opts,
+		res:     a.res.clone(),
+		written: a.written,
+		end:     a.end,
+		err:     a.err,
+
+		detecting: a.detecting,
+		detect:    a.detect[:len(a.detect):len(a.detect)],
+	}
+	snap.t = a.t.clone(snap.token)
+	return snap.finish()
+}
+
+// write analyses the next chunk of the text
+func (a *Analyser) write(p []byte) (int, error) {
+	if a.err != nil {
+		return 0, a.err
+	}
+
+	var cut bool
+	if limit := a.opts.MaxBytes; limit > 0 && a.written+len(p) > limit {
+		p = p[:limit-a.written]
+		cut = true
+	}
+
+	a.feed(p)
+	a.written += len(p)
+	if cut && a.err == nil {
+		a.err = &TruncatedError{Reason: TruncatedByMaxBytes, Offset: a.written}
+	}
+	if a.err != nil {
+		return len(p), a.err
+	}
+	return len(p), nil
+}
+
+// feed passes text on to the tokenizer, holding back the start of the text
+// while its language is being detected
+func (a *Analyser) feed(p []byte) {
+	if !a.detecting {
+		a.t.write(p)
+		return
+	}
+
+	a.detect = append(a.detect, p...)
+	if len(a.detect) >= detectLanguageBytes {
+		a.detectLanguage()
+	}
+}
+
+// detectLanguage detects the language of the text held back so far, switches
+// the analysis to it if it is supported, then analyses the held back text
+func (a *Analyser) detectLanguage() {
+	sample := a.detect
+	if len(sample) > detectLanguageBytes {
+		sample = sample[:detectLanguageBytes]
+	}
+
+	det := detectLanguage(sample)
+	a.res.Detection = &det
+	if det.Language != nil {
+		a.opts.Language = det.Language
+		a.res.opts.Language = det.Language
+	}
+
+	a.detecting = false
+	a.t.write(a.detect)
+	a.detect = nil
+}
+
+// truncate stops the analysis because its context is done
+func (a *Analyser) truncate(err error) {
+	if a.err == nil {
+		a.err = &TruncatedError{Reason: TruncatedByContext, Offset: a.written, Err: err}
+	}
+}
+
+// token adds a token to the analysis unless the word limit has been reached
+func (a *Analyser) token(tok Token) {
+	if a.end >= 0 {
+		return
+	}
+	if tok.Kind == WordToken && a.opts.MaxWords > 0 && a.res.Words >= a.opts.MaxWords {
+		a.err = &TruncatedError{Reason: TruncatedByMaxWords, Offset: tok.Start}
+		a.end = tok.Start
+		return
+	}
+	analyseToken(tok, a.res)
+}
+
+// finish ends the text, flushing any partial word and sentence, and returns
+// the final Results. Nothing more may be written afterwards.
+func (a *Analyser) finish() *Results {
+	if a.detecting {
+		a.detectLanguage()
+	}
+	a.t.close()
+
+	end := a.end
+	if end < 0 {
+		end = a.t.pos.offset
+	}
+	endSentenceLength(end, a.res)
+
+	return a.res
+}
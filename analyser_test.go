@@ -0,0 +1,109 @@
+package textstats
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type AnalyserSuite struct {
+	suite.Suite
+}
+
+func (s *AnalyserSuite) TestWriteInChunks() {
+	opts := Options{WordFrequencies: true, NGrams: true, Linter: NewLinter(), LongSentenceWords: 5}
+	text := pasv + " " + lint + " " + lorem
+	want, _ := AnalyseWithOptions(strings.NewReader(text), opts)
+
+	a := NewAnalyser(opts)
+	for i := 0; i < len(text); i += 7 {
+		end := i + 7
+		if end > len(text) {
+			end = len(text)
+		}
+		n, err := a.Write([]byte(text[i:end]))
+		s.Require().NoError(err)
+		s.Equal(end-i, n)
+	}
+
+	res := a.Results()
+	s.Equal(want.Words, res.Words)
+	s.Equal(want.Sentences, res.Sentences)
+	s.Equal(want.Letters, res.Letters)
+	s.Equal(want.Syllables, res.Syllables)
+	s.Equal(want.DifficultWords, res.DifficultWords)
+	s.Equal(want.WordCountPerSyllableCountByClass, res.WordCountPerSyllableCountByClass)
+	s.Equal(want.SentenceCountPerWordCount, res.SentenceCountPerWordCount)
+	s.Equal(want.LongSentences, res.LongSentences)
+	s.Equal(want.WordFrequencies, res.WordFrequencies)
+	s.Equal(want.TrigramFrequencies, res.TrigramFrequencies)
+	s.Equal(want.Passives, res.Passives)
+	s.Equal(want.Findings, res.Findings)
+	s.Equal(want.MTLD(), res.MTLD())
+}
+
+func (s *AnalyserSuite) TestSnapshots() {
+	a := NewAnalyser(Options{WordFrequencies: true})
+	io.WriteString(a, "Hello Wor")
+
+	// the partial word and unterminated sentence count as if the text ended
+	first := a.Results()
+	s.Equal(2, first.Words)
+	s.Equal(map[int]int{2: 1}, first.SentenceCountPerWordCount)
+	s.Equal(map[string]int{"hello": 1, "wor": 1}, first.WordFrequencies)
+
+	io.WriteString(a, "ld. Bye")
+	second := a.Results()
+	s.Equal(3, second.Words)
+	s.Equal(1, second.Sentences)
+	s.Equal(map[int]int{2: 1, 1: 1}, second.SentenceCountPerWordCount)
+	s.Equal(map[string]int{"hello": 1, "world": 1, "bye": 1}, second.WordFrequencies)
+
+	// taking a snapshot doesn't change earlier ones
+	s.Equal(2, first.Words)
+	s.Equal(map[string]int{"hello": 1, "wor": 1}, first.WordFrequencies)
+}
+
+func (s *AnalyserSuite) TestWriter() {
+	a := NewAnalyser(Options{})
+	n, err := io.Copy(io.MultiWriter(a, ioutil.Discard), strings.NewReader(lorem))
+	s.NoError(err)
+	s.Equal(int64(len(lorem)), n)
+
+	want, _ := Analyse(strings.NewReader(lorem))
+	s.Equal(want.Words, a.Results().Words)
+}
+
+func (s *AnalyserSuite) TestLimits() {
+	a := NewAnalyser(Options{MaxBytes: 11})
+	n, err := io.WriteString(a, hw)
+	s.Equal(11, n)
+	var truncated *TruncatedError
+	s.Require().True(errors.As(err, &truncated))
+	s.Equal(TruncatedByMaxBytes, truncated.Reason)
+
+	n, err = io.WriteString(a, "more")
+	s.Equal(0, n)
+	s.Equal(truncated, err)
+	s.Equal(2, a.Results().Words)
+
+	a = NewAnalyser(Options{MaxWords: 2})
+	_, err = io.WriteString(a, "Hello World")
+	s.NoError(err)
+	_, err = io.WriteString(a, " again")
+	s.NoError(err)
+
+	// the word limit is only noticed once the word is complete
+	s.Equal(2, a.Results().Words)
+	_, err = io.WriteString(a, " and again")
+	s.Error(err)
+	s.Equal(2, a.Results().Words)
+}
+
+func TestAnalyser(t *testing.T) {
+	suite.Run(t, new(AnalyserSuite))
+}
@@ -0,0 +1,134 @@
+package textstats
+
+import (
+	"container/list"
+	"sync"
+)
+
+// SyllableCache is a bounded least recently used cache of the syllable counts
+// and difficulty of words. It is safe for concurrent use, so one cache can be
+// shared by many analyses through Options.Cache. As a few words make up most
+// of any text, even a small cache saves most of the work of counting
+// syllables.
+type SyllableCache struct {
+	mu       sync.Mutex
+	capacity int
+	entries  map[string]*list.Element
+	order    *list.List // most recently used first
+
+	hits   uint64
+	misses uint64
+}
+
+// cacheEntry holds what is known about a word. Syllables and difficulty are
+// looked up separately, so either may not have been worked out yet.
+type cacheEntry struct {
+	word string
+
+	syllables    int
+	hasSyllables bool
+
+	difficult    bool
+	hasDifficult bool
+}
+
+// CacheStats describes how well a SyllableCache is working
+type CacheStats struct {
+	Hits   uint64
+	Misses uint64
+
+	// Size is the number of words in the cache
+	Size int
+}
+
+// HitRate returns the fraction of lookups, between 0 and 1, that were
+// answered from the cache
+func (s CacheStats) HitRate() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+// NewSyllableCache returns an empty cache holding at most capacity words
+func NewSyllableCache(capacity int) *SyllableCache {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &SyllableCache{
+		capacity: capacity,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// Stats returns the number of hits and misses since the cache was created,
+// along with its current size
+func (c *SyllableCache) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return CacheStats{Hits: c.hits, Misses: c.misses, Size: c.order.Len()}
+}
+
+// entry returns the entry for a word, adding it and evicting the least
+// recently used word if needed. It must be called with c.mu held.
+func (c *SyllableCache) entry(word string) *cacheEntry {
+	if el, ok := c.entries[word]; ok {
+		c.order.MoveToFront(el)
+		return el.Value.(*cacheEntry)
+	}
+
+	if c.order.Len() >= c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).word)
+	}
+
+	e := &cacheEntry{word: word}
+	c.entries[word] = c.order.PushFront(e)
+	return e
+}
+
+// syllableCount returns lang.Syllables(word), working it out only if the word
+// isn't in the cache
+func (c *SyllableCache) syllableCount(word string, lang Language) int {
+	c.mu.Lock()
+	e := c.entry(syllableKey(word, lang))
+	if e.hasSyllables {
+		c.hits++
+		sCount := e.syllables
+		c.mu.Unlock()
+		return sCount
+	}
+	c.misses++
+	c.mu.Unlock()
+
+	sCount := lang.Syllables(word)
+
+	c.mu.Lock()
+	e.syllables, e.hasSyllables = sCount, true
+	c.mu.Unlock()
+	return sCount
+}
+
+// isDifficultWord returns isDifficultWord(word), working it out only if the
+// word isn't in the cache
+func (c *SyllableCache) isDifficultWord(word string) bool {
+	c.mu.Lock()
+	e := c.entry(word)
+	if e.hasDifficult {
+		c.hits++
+		difficult := e.difficult
+		c.mu.Unlock()
+		return difficult
+	}
+	c.misses++
+	c.mu.Unlock()
+
+	difficult := isDifficultWord(word)
+
+	c.mu.Lock()
+	e.difficult, e.hasDifficult = difficult, true
+	c.mu.Unlock()
+	return difficult
+}
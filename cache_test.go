@@ -0,0 +1,90 @@
+package textstats
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CacheSuite struct {
+	suite.Suite
+}
+
+func (s *CacheSuite) TestSharedCache() {
+	cache := NewSyllableCache(1000)
+
+	want, _ := Analyse(strings.NewReader(lorem))
+	res, _ := AnalyseWithOptions(strings.NewReader(lorem), Options{Cache: cache})
+	s.Equal(want.Syllables, res.Syllables)
+	s.Equal(want.DifficultWords, res.DifficultWords)
+
+	first := cache.Stats()
+	s.Equal(uint64(2*first.Size), first.Misses)
+
+	// a second analysis of the same text is answered entirely from the cache
+	AnalyseWithOptions(strings.NewReader(lorem), Options{Cache: cache})
+	second := cache.Stats()
+	s.Equal(first.Misses, second.Misses)
+	s.Equal(uint64(2*want.Words), second.Hits-first.Hits)
+	s.True(second.HitRate() > 0.5)
+}
+
+func (s *CacheSuite) TestEviction() {
+	cache := NewSyllableCache(2)
+	s.Equal(2, cache.syllableCount("hello", English))
+	s.Equal(1, cache.syllableCount("world", English))
+	s.Equal(2, cache.syllableCount("hello", English))
+	s.Equal(4, cache.syllableCount("absolutely", English))
+
+	// world was the least recently used, so was evicted
+	stats := cache.Stats()
+	s.Equal(CacheStats{Hits: 1, Misses: 3, Size: 2}, stats)
+	s.Equal(0.25, stats.HitRate())
+	cache.syllableCount("world", English)
+	s.Equal(uint64(4), cache.Stats().Misses)
+
+	s.Equal(0.0, CacheStats{}.HitRate())
+}
+
+func (s *CacheSuite) TestConcurrentAnalyses() {
+	cache := NewSyllableCache(50)
+	want, _ := Analyse(strings.NewReader(pasv + " " + lorem))
+
+	var wg sync.WaitGroup
+	results := make([]*Results, 8)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], _ = AnalyseWithOptions(strings.NewReader(pasv+" "+lorem), Options{Cache: cache})
+		}(i)
+	}
+	wg.Wait()
+
+	for _, res := range results {
+		s.Equal(want.Syllables, res.Syllables)
+		s.Equal(want.DifficultWords, res.DifficultWords)
+	}
+	s.Equal(50, cache.Stats().Size)
+}
+
+func TestSyllableCache(t *testing.T) {
+	suite.Run(t, new(CacheSuite))
+}
+
+func BenchmarkAnalyseSharedCache(b *testing.B) {
+	cache := NewSyllableCache(10000)
+	b.SetBytes(int64(len(benchCorpus)))
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := AnalyseWithOptions(bytes.NewReader(benchCorpus), Options{Cache: cache}); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.ReportMetric(cache.Stats().HitRate()*100, "%hits")
+}
@@ -0,0 +1,136 @@
+package textstats
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/rangetable"
+)
+
+// isCJK reports whether r is a Chinese character, Japanese kanji or kana, or
+// the katakana prolonged sound mark. As Chinese and Japanese aren't written
+// with spaces between words, each of these is counted as a word of its own.
+func isCJK(r rune) bool {
+	return r >= 0x3000 && (unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana) || r == 'ー')
+}
+
+// unspacedScripts are the scripts written without spaces between words that
+// the analysis can't split into words
+var unspacedScripts = []struct {
+	name  string
+	table *unicode.RangeTable
+}{
+	{"Thai", unicode.Thai},
+	{"Lao", unicode.Lao},
+	{"Myanmar", unicode.Myanmar},
+	{"Khmer", unicode.Khmer},
+}
+
+// unspacedLetters holds the runes of all the unspaced scripts, and
+// unspacedFirst and unspacedLast are the lowest and highest of them, to rule
+// out most other letters without a lookup
+var (
+	unspacedLetters = rangetable.Merge(unicode.Thai, unicode.Lao, unicode.Myanmar, unicode.Khmer)
+	unspacedFirst   = rune(unspacedLetters.R16[0].Lo)
+	unspacedLast    = rune(unspacedLetters.R16[len(unspacedLetters.R16)-1].Hi)
+)
+
+// isUnspacedLetter reports whether a letter belongs to an unspaced script
+func isUnspacedLetter(l rune) bool {
+	return l >= unspacedFirst && l <= unspacedLast && unicode.Is(unspacedLetters, l)
+}
+
+// unspacedScript returns the name of the unspaced script a letter belongs
+// to, or an empty string if it doesn't belong to one
+func unspacedScript(l rune) string {
+	if !isUnspacedLetter(l) {
+		return ""
+	}
+	for _, script := range unspacedScripts {
+		if unicode.Is(script.table, l) {
+			return script.name
+		}
+	}
+	return ""
+}
+
+// analyseUnsupportedLetter counts a letter in an unspaced script
+func analyseUnsupportedLetter(l rune, res *Results) {
+	name := unspacedScript(l)
+	if name == "" {
+		return
+	}
+	if res.UnsupportedScripts == nil {
+		res.UnsupportedScripts = make(map[string]int)
+	}
+	res.UnsupportedScripts[name]++
+}
+
+// analyseCJK counts a word made of a single Chinese or Japanese character
+func analyseCJK(word string, res *Results) {
+	r, _ := utf8.DecodeRuneInString(word)
+	if !unicode.Is(unicode.Han, r) {
+		res.KanaCharacters++
+		return
+	}
+
+	res.HanCharacters++
+	if _, ok := CommonHanCharacters[r]; !ok {
+		res.UncommonHanCharacters++
+	}
+}
+
+// cjkSyllables counts each Chinese or Japanese character in a word as a
+// syllable, falling back to English rules for words in other scripts
+func cjkSyllables(word string) int {
+	var sCount int
+	for _, r := range word {
+		if isCJK(r) {
+			sCount++
+		}
+	}
+	if sCount == 0 {
+		return syllableCount(word)
+	}
+	return sCount
+}
+
+// runeSet returns the set of runes in s
+func runeSet(s string) map[rune]struct{} {
+	set := make(map[rune]struct{}, utf8.RuneCountInString(s))
+	for _, r := range s {
+		set[r] = struct{}{}
+	}
+	return set
+}
+
+// AverageCharactersPerSentence returns the average number of Chinese and
+// Japanese characters in each sentence. A text without any sentence
+// terminators counts as one sentence.
+func (r *Results) AverageCharactersPerSentence() float64 {
+	if r.Sentences == 0 {
+		return float64(r.HanCharacters + r.KanaCharacters)
+	}
+	return float64(r.HanCharacters+r.KanaCharacters) / float64(r.Sentences)
+}
+
+// UncommonCharacterPercentage returns the percentage of Chinese characters
+// that aren't among the CommonHanCharacters. Readers learn characters roughly
+// in order of frequency, so texts with more uncommon characters are harder.
+// It is 0 for a text without Chinese characters.
+func (r *Results) UncommonCharacterPercentage() float64 {
+	if r.HanCharacters == 0 {
+		return 0
+	}
+	return float64(r.UncommonHanCharacters) / float64(r.HanCharacters) * 100
+}
+
+// KanjiPercentage returns the percentage of Japanese characters that are
+// kanji rather than kana. Texts with more kanji are harder to read. It is 0
+// for a text without Japanese characters.
+func (r *Results) KanjiPercentage() float64 {
+	if r.HanCharacters+r.KanaCharacters == 0 {
+		return 0
+	}
+	return float64(r.HanCharacters) / float64(r.HanCharacters+r.KanaCharacters) * 100
+}
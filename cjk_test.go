@@ -0,0 +1,91 @@
+package textstats
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CJKSuite struct {
+	suite.Suite
+}
+
+func (s *CJKSuite) TestTokenize() {
+	var words []string
+	var ends int
+	t := Tokenize(strings.NewReader("我们学中文。Go很好！"))
+	for t.Next() {
+		switch tok := t.Token(); tok.Kind {
+		case WordToken:
+			words = append(words, tok.Text)
+		case SentenceEndToken:
+			ends++
+		}
+	}
+	s.Equal([]string{"我", "们", "学", "中", "文", "Go", "很", "好"}, words)
+	s.Equal(2, ends)
+}
+
+func (s *CJKSuite) TestChinese() {
+	res, err := AnalyseWithOptions(strings.NewReader("我们在学校学习中文。你好吗？今天天气很好，鑫淼！"), Options{Language: Chinese})
+	s.NoError(err)
+	s.Equal(20, res.Words)
+	s.Equal(20, res.Letters)
+	s.Equal(20, res.Syllables)
+	s.Equal(3, res.Sentences)
+	s.Equal(0, res.DifficultWords)
+	s.Equal(20, res.WordsOfClass(CJKCharacter))
+	s.Equal(20, res.HanCharacters)
+	s.Equal(0, res.KanaCharacters)
+	s.Equal(2, res.UncommonHanCharacters)
+	s.Equal([]Score{
+		{ID: "average_characters_per_sentence", Name: "Average Characters/Sentence", Value: 20.0 / 3},
+		{ID: "uncommon_character_percentage", Name: "Uncommon Characters %", Value: 10},
+	}, Chinese.Scores(res))
+}
+
+func (s *CJKSuite) TestJapanese() {
+	res, _ := AnalyseWithOptions(strings.NewReader("私は学校で日本語を勉強しています。"), Options{Language: Japanese})
+	s.Equal(16, res.Words)
+	s.Equal(1, res.Sentences)
+	s.Equal(8, res.HanCharacters)
+	s.Equal(8, res.KanaCharacters)
+	s.Equal(50.0, res.KanjiPercentage())
+
+	// other scripts are counted as usual
+	s.Equal(2, Japanese.Syllables("hello"))
+	s.Equal(3, Japanese.Syllables("カード"))
+}
+
+func (s *CJKSuite) TestScoresWithoutSentencesOrCharacters() {
+	res, _ := AnalyseWithOptions(strings.NewReader("我们学中文"), Options{Language: Chinese})
+	s.Equal(0, res.Sentences)
+	s.Equal(5.0, res.AverageCharactersPerSentence())
+
+	res, _ = AnalyseWithOptions(strings.NewReader(""), Options{Language: Japanese})
+	for _, score := range append(Japanese.Scores(res), Chinese.Scores(res)...) {
+		s.Equal(0.0, score.Value, score.ID)
+	}
+}
+
+func (s *CJKSuite) TestUnsupportedScripts() {
+	res, _ := Analyse(strings.NewReader("ภาษาไทย hello"))
+	s.Equal(2, res.Words)
+	s.Equal(map[string]int{"Thai": 7}, res.UnsupportedScripts)
+
+	res, _ = Analyse(strings.NewReader(lorem))
+	s.Nil(res.UnsupportedScripts)
+}
+
+func (s *CJKSuite) TestDetect() {
+	det, _ := DetectLanguage(strings.NewReader("我们在学校学习中文，今天天气很好。"))
+	s.Equal(Chinese, det.Language)
+
+	det, _ = DetectLanguage(strings.NewReader("私は学校で日本語を勉強しています。"))
+	s.Equal(Japanese, det.Language)
+}
+
+func TestCJKSuite(t *testing.T) {
+	suite.Run(t, new(CJKSuite))
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+
+	"github.com/darkliquid/textstats"
+)
+
+// easeScores are the scores that go up as a text gets easier to read, unlike
+// grade levels and counts
+var easeScores = map[string]bool{
+	"flesch_kincaid_reading_ease":  true,
+	"amstad_reading_ease":          true,
+	"fernandez_huerta_readability": true,
+	"szigriszt_pazos_perspicuity":  true,
+	"kandel_moles_reading_ease":    true,
+	"douma_reading_ease":           true,
+}
+
+// harder returns how much harder to read a change to a score makes a text
+func harder(d textstats.Delta) float64 {
+	if easeScores[d.ID] {
+		return -d.Change()
+	}
+	return d.Change()
+}
+
+// formatValue prints counts as whole numbers and anything else as printStats
+// does
+func formatValue(v float64) string {
+	if v == math.Trunc(v) {
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return strconv.FormatFloat(v, 'f', 6, 64)
+}
+
+func printDeltas(deltas []textstats.Delta) {
+	for _, d := range deltas {
+		change := formatValue(d.Change())
+		if d.Change() >= 0 {
+			change = "+" + change
+		}
+		fmt.Printf("\t%-28s %s → %s (%s)\n", d.Name, formatValue(d.Old), formatValue(d.New), change)
+	}
+	fmt.Println()
+}
+
+// printSentenceChanges prints the n sentence changes that moved the
+// Flesch-Kincaid grade level most
+func printSentenceChanges(oldName, newName string, oldData, newData []byte, changes []textstats.SentenceChange, n int) {
+	sorted := append([]textstats.SentenceChange(nil), changes...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return math.Abs(sorted[i].GradeImpact) > math.Abs(sorted[j].GradeImpact)
+	})
+	if len(sorted) > n {
+		sorted = sorted[:n]
+	}
+
+	fmt.Println("Sentences that moved the Flesch-Kincaid grade level most:")
+	fmt.Println()
+	for _, c := range sorted {
+		fmt.Printf("\t%+f FK grade, %s", c.GradeImpact, c.Kind)
+		if c.Kind != textstats.SentenceAdded {
+			line, col := lineColumn(oldData, c.Old.Start)
+			fmt.Printf(" %s:%d:%d", oldName, line, col)
+		}
+		if c.Kind != textstats.SentenceRemoved {
+			line, col := lineColumn(newData, c.New.Start)
+			fmt.Printf(" %s:%d:%d", newName, line, col)
+		}
+		fmt.Println()
+		if c.OldText != "" {
+			fmt.Printf("\t\t- %s\n", c.OldText)
+		}
+		if c.NewText != "" {
+			fmt.Printf("\t\t+ %s\n", c.NewText)
+		}
+	}
+	fmt.Println()
+}
+
+func readFile(filename string) []byte {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	return data
+}
+
+func diffMain(args []string) {
+	flags := flag.NewFlagSet("diff", flag.ExitOnError)
+	scoreName := flags.String("score", "flesch_kincaid_grade_level", "check the `SCORE` named as in the JSON report")
+	threshold := flags.Float64("threshold", -1, "exit with status 1 if the score gets harder by more than `N`, or never if negative")
+	top := flags.Int("sentences", 3, "show the `N` changed sentences that moved the Flesch-Kincaid grade level most")
+	langCode := flags.String("lang", "en", "analyse text in the language with ISO 639-1 `CODE`, or auto to detect it")
+	flags.Usage = func() {
+		fmt.Println("Usage:", os.Args[0], "diff [options] old new")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+
+	var opts textstats.Options
+	if flags.NArg() != 2 || !setLanguage(*langCode, &opts) {
+		flags.Usage()
+		os.Exit(1)
+	}
+	checkScore(*scoreName, opts)
+
+	oldName, newName := flags.Arg(0), flags.Arg(1)
+	oldData, newData := readFile(oldName), readFile(newName)
+
+	c, err := textstats.CompareTexts(bytes.NewReader(oldData), bytes.NewReader(newData), opts)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	score, ok := c.Score(*scoreName)
+	if !ok {
+		fmt.Printf("no %s score in the language detected\n", *scoreName)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Changes from %q to %q:\n\n", oldName, newName)
+	printDeltas(c.Measures)
+	fmt.Println("Readability Scores:")
+	printDeltas(c.Scores)
+	if len(c.Sentences) > 0 && *top > 0 {
+		printSentenceChanges(oldName, newName, oldData, newData, c.Sentences, *top)
+	}
+
+	if *threshold >= 0 && harder(score) > *threshold {
+		fmt.Printf("%s went from %f to %f, which is harder to read by more than %g\n", score.Name, score.Old, score.New, *threshold)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,245 @@
+package main
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/darkliquid/textstats"
+)
+
+// lintedFile is a file and the findings in it
+type lintedFile struct {
+	name     string
+	data     []byte
+	findings []textstats.Finding
+}
+
+// findingFormats write findings in the formats other tools read, by the
+// name given to -format
+var findingFormats = map[string]func(w io.Writer, files []lintedFile) error{
+	"text":       writeText,
+	"github":     writeGitHub,
+	"checkstyle": writeCheckstyle,
+	"sarif":      writeSARIF,
+}
+
+// findingMessage describes a finding
+func findingMessage(f textstats.Finding) string {
+	msg := f.Message
+	if f.Text != "" {
+		msg += fmt.Sprintf(": %q", f.Text)
+	}
+	if f.Suggestion != "" {
+		msg += fmt.Sprintf(" (use %q)", f.Suggestion)
+	}
+	return msg
+}
+
+// lineBreaks replaces line breaks in a message with spaces, to keep each
+// finding on one line
+var lineBreaks = strings.NewReplacer("\r\n", " ", "\r", " ", "\n", " ")
+
+// writeText writes findings as file:line:col: message, which editors and
+// quickfix lists understand
+func writeText(w io.Writer, files []lintedFile) error {
+	for _, file := range files {
+		for _, f := range file.findings {
+			line, col := lineColumn(file.data, f.Start)
+			if _, err := fmt.Fprintf(w, "%s:%d:%d: %s\n", file.name, line, col, lineBreaks.Replace(findingMessage(f))); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// gitHubEscaper escapes the text of a GitHub Actions workflow command, and
+// gitHubPropertyEscaper its properties
+var (
+	gitHubEscaper         = strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A")
+	gitHubPropertyEscaper = strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C")
+)
+
+// writeGitHub writes findings as GitHub Actions warning commands, which show
+// them on the lines of a pull request
+func writeGitHub(w io.Writer, files []lintedFile) error {
+	for _, file := range files {
+		for _, f := range file.findings {
+			line, col := lineColumn(file.data, f.Start)
+			endLine, endCol := lineColumn(file.data, f.End)
+			_, err := fmt.Fprintf(w, "::warning file=%s,line=%d,col=%d,endLine=%d,endColumn=%d,title=%s::%s\n",
+				gitHubPropertyEscaper.Replace(file.name), line, col, endLine, endCol,
+				gitHubPropertyEscaper.Replace(string(f.Kind)), gitHubEscaper.Replace(findingMessage(f)))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+type checkstyleReport struct {
+	XMLName xml.Name         `xml:"checkstyle"`
+	Version string           `xml:"version,attr"`
+	Files   []checkstyleFile `xml:"file"`
+}
+
+type checkstyleFile struct {
+	Name   string            `xml:"name,attr"`
+	Errors []checkstyleError `xml:"error"`
+}
+
+type checkstyleError struct {
+	Line     int    `xml:"line,attr"`
+	Column   int    `xml:"column,attr"`
+	Severity string `xml:"severity,attr"`
+	Message  string `xml:"message,attr"`
+	Source   string `xml:"source,attr"`
+}
+
+// writeCheckstyle writes findings as checkstyle XML
+func writeCheckstyle(w io.Writer, files []lintedFile) error {
+	report := checkstyleReport{Version: "4.3"}
+	for _, file := range files {
+		cf := checkstyleFile{Name: file.name}
+		for _, f := range file.findings {
+			line, col := lineColumn(file.data, f.Start)
+			cf.Errors = append(cf.Errors, checkstyleError{
+				Line:     line,
+				Column:   col,
+				Severity: "warning",
+				Message:  findingMessage(f),
+				Source:   "textstats." + string(f.Kind),
+			})
+		}
+		report.Files = append(report.Files, cf)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(report); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+type sarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool       sarifTool     `json:"tool"`
+	ColumnKind string        `json:"columnKind"`
+	Results    []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID string `json:"id"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn"`
+	EndLine     int `json:"endLine"`
+	EndColumn   int `json:"endColumn"`
+}
+
+// fileURI returns the URI of a file for a SARIF log, which is relative unless
+// the file's path is absolute
+func fileURI(name string) string {
+	u := url.URL{Path: filepath.ToSlash(name)}
+	if filepath.IsAbs(name) {
+		u.Scheme = "file"
+	}
+	return u.String()
+}
+
+// writeSARIF writes findings as a SARIF 2.1.0 log, which code scanning
+// services read
+func writeSARIF(w io.Writer, files []lintedFile) error {
+	run := sarifRun{
+		Tool: sarifTool{Driver: sarifDriver{
+			Name:           "textstats",
+			InformationURI: "https://github.com/darkliquid/textstats",
+			Rules:          []sarifRule{},
+		}},
+		// columns are counted in runes, as lineColumn does
+		ColumnKind: "unicodeCodePoints",
+		Results:    []sarifResult{},
+	}
+
+	rules := make(map[textstats.FindingKind]bool)
+	for _, file := range files {
+		for _, f := range file.findings {
+			if !rules[f.Kind] {
+				rules[f.Kind] = true
+				run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule{ID: string(f.Kind)})
+			}
+
+			line, col := lineColumn(file.data, f.Start)
+			endLine, endCol := lineColumn(file.data, f.End)
+			run.Results = append(run.Results, sarifResult{
+				RuleID:  string(f.Kind),
+				Level:   "warning",
+				Message: sarifMessage{Text: findingMessage(f)},
+				Locations: []sarifLocation{{PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{URI: fileURI(file.name)},
+					Region:           sarifRegion{StartLine: line, StartColumn: col, EndLine: endLine, EndColumn: endCol},
+				}}},
+			})
+		}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(sarifLog{
+		Version: "2.1.0",
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Runs:    []sarifRun{run},
+	})
+}
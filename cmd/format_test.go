@@ -0,0 +1,63 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/darkliquid/textstats"
+	"github.com/stretchr/testify/suite"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// findingFiles have a name and a message that need escaping in every format
+var findingFiles = []lintedFile{
+	{
+		name: "docs/a,b:c.md",
+		data: []byte("It is 100% very\ngood.\n"),
+		findings: []textstats.Finding{
+			{Kind: textstats.WeaselWordFinding, Start: 11, End: 15, Text: "very", Message: "weasel word"},
+			{Kind: textstats.HardSentenceFinding, Start: 0, End: 21, Message: "first line\nsecond line, 100%"},
+		},
+	},
+	{
+		name: "naïve.txt",
+		data: []byte("naïve utilise"),
+		findings: []textstats.Finding{
+			{Kind: textstats.PlainLanguageFinding, Start: 7, End: 14, Text: "utilise", Message: "complex wording", Suggestion: "use"},
+		},
+	},
+}
+
+type FormatSuite struct {
+	suite.Suite
+}
+
+func (s *FormatSuite) TestGolden() {
+	for format, write := range findingFormats {
+		var buf bytes.Buffer
+		s.NoError(write(&buf, findingFiles))
+
+		golden := filepath.Join("testdata", "findings."+format)
+		if *update {
+			s.NoError(ioutil.WriteFile(golden, buf.Bytes(), 0644))
+		}
+		expected, err := ioutil.ReadFile(golden)
+		s.NoError(err)
+		s.Equal(string(expected), buf.String(), format)
+	}
+}
+
+func (s *FormatSuite) TestFileURI() {
+	s.Equal("docs/a,b:c.md", fileURI("docs/a,b:c.md"))
+	s.Equal("./a:b.md", fileURI("a:b.md"))
+	s.Equal("dir/100%25.md", fileURI("dir/100%.md"))
+	s.Equal("file:///tmp/x%20y.md", fileURI("/tmp/x y.md"))
+}
+
+func TestFormatSuite(t *testing.T) {
+	suite.Run(t, new(FormatSuite))
+}
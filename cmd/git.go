@@ -0,0 +1,367 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/darkliquid/textstats"
+)
+
+// defaultExtensions are the kinds of file analysed in a repository unless
+// -ext says otherwise
+const defaultExtensions = ".md,.markdown,.txt,.rst,.adoc"
+
+// runGit runs git in a repository and returns what it prints
+func runGit(dir string, args ...string) ([]byte, error) {
+	cmd := exec.Command("git", append([]string{"-C", dir, "-c", "core.quotePath=false"}, args...)...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("git %s: %s", args[0], msg)
+		}
+		return nil, err
+	}
+	return out, nil
+}
+
+// blobReader reads files as they were at any revision through a single
+// long running git cat-file process
+type blobReader struct {
+	cmd *exec.Cmd
+	in  io.WriteCloser
+	out *bufio.Reader
+}
+
+func newBlobReader(dir string) (*blobReader, error) {
+	cmd := exec.Command("git", "-C", dir, "cat-file", "--batch")
+	in, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	out, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	return &blobReader{cmd: cmd, in: in, out: bufio.NewReader(out)}, nil
+}
+
+// read returns the contents of a file at a revision
+func (b *blobReader) read(rev, path string) ([]byte, error) {
+	if _, err := fmt.Fprintf(b.in, "%s:%s\n", rev, path); err != nil {
+		return nil, err
+	}
+
+	// the header is "<object> blob <size>" or "<name> missing"
+	header, err := b.out.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	fields := strings.Fields(header)
+	if len(fields) != 3 {
+		return nil, fmt.Errorf("%s:%s: %s", rev, path, strings.TrimSpace(header))
+	}
+	size, err := strconv.Atoi(fields[2])
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]byte, size+1)
+	if _, err := io.ReadFull(b.out, data); err != nil {
+		return nil, err
+	}
+	return data[:size], nil
+}
+
+func (b *blobReader) close() error {
+	b.in.Close()
+	return b.cmd.Wait()
+}
+
+// fileChange is a file added, modified or deleted between two revisions
+type fileChange struct {
+	status byte
+	path   string
+}
+
+// parseNameStatus parses the output of git's --name-status -z option
+func parseNameStatus(out []byte) []fileChange {
+	var changes []fileChange
+	fields := strings.Split(strings.TrimSuffix(string(out), "\x00"), "\x00")
+	for i := 0; i+1 < len(fields); i += 2 {
+		changes = append(changes, fileChange{status: fields[i][0], path: fields[i+1]})
+	}
+	return changes
+}
+
+// hasExtension reports whether a file has one of a comma separated list of
+// extensions
+func hasExtension(path, extensions string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	for _, e := range strings.Split(extensions, ",") {
+		if ext != "" && ext == strings.ToLower(strings.TrimSpace(e)) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkScore exits with an error unless id names a score of the language
+// text is analysed in, or of any language if it is detected, or a measure
+func checkScore(id string, opts textstats.Options) {
+	langs := []textstats.Language{textstats.English}
+	if opts.DetectLanguage {
+		langs = textstats.Languages
+	} else if opts.Language != nil {
+		langs = []textstats.Language{opts.Language}
+	}
+
+	for _, lang := range langs {
+		for _, score := range textstats.ScoreIDs(lang) {
+			if score == id {
+				return
+			}
+		}
+	}
+
+	fmt.Printf("unknown score %q\n", id)
+	os.Exit(1)
+}
+
+// gitFlags are the flags shared by the git modes
+type gitFlags struct {
+	dir        *string
+	extensions *string
+	score      *string
+	lang       *string
+}
+
+func newGitFlags(flags *flag.FlagSet) gitFlags {
+	return gitFlags{
+		dir:        flags.String("C", ".", "use the git repository in `DIR`"),
+		extensions: flags.String("ext", defaultExtensions, "analyse files with these comma separated `EXTENSIONS`"),
+		score:      flags.String("score", "flesch_kincaid_grade_level", "report the `SCORE` named as in the JSON report"),
+		lang:       flags.String("lang", "en", "analyse text in the language with ISO 639-1 `CODE`, or auto to detect it"),
+	}
+}
+
+func changedMain(args []string) {
+	flags := flag.NewFlagSet("changed", flag.ExitOnError)
+	gf := newGitFlags(flags)
+	threshold := flags.Float64("threshold", -1, "exit with status 1 if the score of any file gets harder by more than `N`, or never if negative")
+	flags.Usage = func() {
+		fmt.Println("Usage:", os.Args[0], "changed [options] from [to] [-- paths]")
+		fmt.Println()
+		fmt.Println("Compares the files changed between two revisions, or between a revision")
+		fmt.Println("and the working tree if to is left out.")
+		fmt.Println()
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+
+	var opts textstats.Options
+	revs := flags.Args()
+	var paths []string
+	for i, arg := range revs {
+		if arg == "--" {
+			revs, paths = revs[:i], revs[i+1:]
+			break
+		}
+	}
+	if len(revs) < 1 || len(revs) > 2 || !setLanguage(*gf.lang, &opts) {
+		flags.Usage()
+		os.Exit(1)
+	}
+	checkScore(*gf.score, opts)
+	from, to := revs[0], ""
+	if len(revs) == 2 {
+		to = revs[1]
+	}
+
+	top, err := runGit(*gf.dir, "rev-parse", "--show-toplevel")
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	root := strings.TrimSpace(string(top))
+
+	diffArgs := append([]string{"diff", "--name-status", "--no-renames", "-z", from}, revs[1:]...)
+	out, err := runGit(*gf.dir, append(append(diffArgs, "--"), paths...)...)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	blobs, err := newBlobReader(root)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	defer blobs.close()
+
+	var failed bool
+	for _, change := range parseNameStatus(out) {
+		if !hasExtension(change.path, *gf.extensions) {
+			continue
+		}
+
+		if change.status == 'D' {
+			fmt.Printf("%s: deleted\n", change.path)
+			continue
+		}
+
+		var before, after []byte
+		if change.status != 'A' {
+			if before, err = blobs.read(from, change.path); err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+		}
+		if to == "" {
+			after, err = ioutil.ReadFile(filepath.Join(root, change.path))
+		} else {
+			after, err = blobs.read(to, change.path)
+		}
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		c, err := textstats.CompareTexts(bytes.NewReader(before), bytes.NewReader(after), opts)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		score, ok := c.Score(*gf.score)
+		if !ok {
+			// the language detected for this file has no such score
+			fmt.Printf("%s: no %s score in the language detected\n", change.path, *gf.score)
+			continue
+		}
+
+		if change.status == 'A' {
+			fmt.Printf("%s: added, %s %s\n", change.path, score.Name, formatValue(score.New))
+			continue
+		}
+
+		fmt.Printf("%s: %s %s → %s (%+f)\n", change.path, score.Name, formatValue(score.Old), formatValue(score.New), score.Change())
+		if *threshold >= 0 && harder(score) > *threshold {
+			fmt.Fprintf(os.Stderr, "%s: %s went from %f to %f, which is harder to read by more than %g\n", change.path, score.Name, score.Old, score.New, *threshold)
+			failed = true
+		}
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+}
+
+func historyMain(args []string) {
+	flags := flag.NewFlagSet("history", flag.ExitOnError)
+	gf := newGitFlags(flags)
+	rev := flags.String("rev", "HEAD", "walk the history leading up to `REVISION`")
+	flags.Usage = func() {
+		fmt.Println("Usage:", os.Args[0], "history [options] [paths]")
+		fmt.Println()
+		fmt.Println("Prints the score of each file as CSV each time a commit changed it, oldest")
+		fmt.Println("first.")
+		fmt.Println()
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+
+	var opts textstats.Options
+	if !setLanguage(*gf.lang, &opts) {
+		flags.Usage()
+		os.Exit(1)
+	}
+	checkScore(*gf.score, opts)
+
+	out, err := runGit(*gf.dir, append([]string{"log", "--reverse", "--format=%H %cI", *rev, "--"}, flags.Args()...)...)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	top, err := runGit(*gf.dir, "rev-parse", "--show-toplevel")
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	root := strings.TrimSpace(string(top))
+
+	blobs, err := newBlobReader(root)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	defer blobs.close()
+
+	w := csv.NewWriter(os.Stdout)
+	w.Write([]string{"commit", "date", "file", "words", "sentences", *gf.score})
+
+	for _, commit := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		fields := strings.SplitN(commit, " ", 2)
+		if len(fields) != 2 {
+			continue
+		}
+		hash, date := fields[0], fields[1]
+
+		// paths given on the command line are relative to -C, so the files
+		// changed are listed from there too
+		treeArgs := []string{"diff-tree", "-r", "--root", "--no-commit-id", "--name-status", "--no-renames", "-z", hash, "--"}
+		files, err := runGit(*gf.dir, append(treeArgs, flags.Args()...)...)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		for _, change := range parseNameStatus(files) {
+			if change.status == 'D' || !hasExtension(change.path, *gf.extensions) {
+				continue
+			}
+
+			data, err := blobs.read(hash, change.path)
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+			res, err := textstats.AnalyseWithOptions(bytes.NewReader(data), opts)
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+
+			// the score is left empty if the language detected for the
+			// file doesn't have it
+			var score string
+			if value, ok := res.Score(*gf.score); ok {
+				score = strconv.FormatFloat(value, 'f', -1, 64)
+			}
+
+			w.Write([]string{
+				hash,
+				date,
+				change.path,
+				strconv.Itoa(res.Words),
+				strconv.Itoa(res.Sentences),
+				score,
+			})
+		}
+		w.Flush()
+	}
+}
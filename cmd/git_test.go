@@ -0,0 +1,88 @@
+package main
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type GitSuite struct {
+	suite.Suite
+}
+
+// nopWriteCloser discards what the blobReader asks git for
+type nopWriteCloser struct{}
+
+func (nopWriteCloser) Write(p []byte) (int, error) { return len(p), nil }
+func (nopWriteCloser) Close() error                { return nil }
+
+func (s *GitSuite) TestParseNameStatus() {
+	tests := []struct {
+		out      string
+		expected []fileChange
+	}{
+		{"", nil},
+		{"M\x00README.md\x00", []fileChange{{'M', "README.md"}}},
+		{
+			"A\x00docs/new file.md\x00D\x00old.txt\x00M\x00naïve.md\x00",
+			[]fileChange{{'A', "docs/new file.md"}, {'D', "old.txt"}, {'M', "naïve.md"}},
+		},
+		// a trailing status without a path is ignored
+		{"M\x00a.md\x00A\x00", []fileChange{{'M', "a.md"}}},
+	}
+
+	for _, test := range tests {
+		s.Equal(test.expected, parseNameStatus([]byte(test.out)), "%q", test.out)
+	}
+}
+
+func (s *GitSuite) TestHasExtension() {
+	tests := []struct {
+		path, extensions string
+		expected         bool
+	}{
+		{"README.md", defaultExtensions, true},
+		{"docs/GUIDE.MD", defaultExtensions, true},
+		{"main.go", defaultExtensions, false},
+		{"Makefile", defaultExtensions, false},
+		{"notes.txt", ".md, .txt", true},
+		{"notes.txt", "", false},
+	}
+
+	for _, test := range tests {
+		s.Equal(test.expected, hasExtension(test.path, test.extensions), "%s in %q", test.path, test.extensions)
+	}
+}
+
+func (s *GitSuite) TestBlobReaderRead() {
+	tests := []struct {
+		out      string
+		expected []string
+		err      string
+	}{
+		{"abc123 blob 5\nhello\n", []string{"hello"}, ""},
+		{"abc123 blob 0\n\nabc124 blob 3\nbye\n", []string{"", "bye"}, ""},
+		{"HEAD:gone.md missing\n", nil, "HEAD:gone.md: HEAD:gone.md missing"},
+		{"abc123 blob x\n", nil, "invalid syntax"},
+	}
+
+	for _, test := range tests {
+		b := &blobReader{in: nopWriteCloser{}, out: bufio.NewReader(strings.NewReader(test.out))}
+		for _, expected := range test.expected {
+			data, err := b.read("HEAD", "file.md")
+			s.NoError(err)
+			s.Equal(expected, string(data))
+		}
+		if test.err != "" {
+			_, err := b.read("HEAD", "gone.md")
+			s.Error(err)
+			s.Contains(err.Error(), test.err)
+		}
+	}
+}
+
+func TestGitSuite(t *testing.T) {
+	suite.Run(t, new(GitSuite))
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/darkliquid/textstats"
+)
+
+// lineColumn converts a byte offset into a one based line and column, counting
+// columns in runes
+func lineColumn(data []byte, offset int) (int, int) {
+	line := bytes.Count(data[:offset], []byte("\n")) + 1
+	start := bytes.LastIndexByte(data[:offset], '\n') + 1
+	return line, len([]rune(string(data[start:offset]))) + 1
+}
+
+func lintMain(args []string) {
+	flags := flag.NewFlagSet("lint", flag.ExitOnError)
+	noAdverbs := flags.Bool("no-adverbs", false, "don't flag -ly adverbs")
+	maxGrade := flags.Float64("max-grade", 0, "flag sentences with a Flesch-Kincaid grade level above `N`, or none if 0")
+	format := flags.String("format", "text", "output `FORMAT`: text, github, checkstyle or sarif")
+	flags.Usage = func() {
+		fmt.Println("Usage:", os.Args[0], "lint [options] [filenames]")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+
+	write, ok := findingFormats[*format]
+	if !ok {
+		flags.Usage()
+		os.Exit(1)
+	}
+
+	var files []lintedFile
+	if flags.NArg() > 0 {
+		for _, name := range flags.Args() {
+			files = append(files, lintedFile{name: name, data: readFile(name)})
+		}
+	} else {
+		name, input := openInput(flags.Args(), flags.Usage)
+		data, err := ioutil.ReadAll(input)
+		input.Close()
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		files = append(files, lintedFile{name: name, data: data})
+	}
+
+	linter := textstats.NewLinter()
+	linter.Adverbs = !*noAdverbs
+	linter.MaxSentenceGrade = *maxGrade
+
+	var found bool
+	for i := range files {
+		findings, err := linter.Lint(bytes.NewReader(files[i].data))
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		files[i].findings = findings
+		found = found || len(findings) > 0
+	}
+
+	if err := write(os.Stdout, files); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	if found {
+		os.Exit(1)
+	}
+}
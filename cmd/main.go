@@ -1,14 +1,18 @@
 package main
 
 import (
+	"bytes"
+	"flag"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"sort"
 
 	termutil "github.com/andrew-d/go-termutil"
 	"github.com/darkliquid/textstats"
 )
 
-func printStats(name string, res *textstats.Results) {
+func printStats(name string, res *textstats.Results, lang textstats.Language) {
 	fmt.Printf("Statistics for %q:\n", name)
 	fmt.Printf(`
 	Words              %d
@@ -18,19 +22,16 @@ func printStats(name string, res *textstats.Results) {
 	Spaces             %d
 	Syllables          %d
 	Difficult Words    %d
+	Function Words     %d
+	Content Words      %d
+	Passive Sentences  %d
+	Proper Nouns       %d
+	Acronyms           %d
+	Numbers            %d
+	Compound Words     %d
 	Avg Letters/Word   %f
 	Avg Syllables/Word %f
 	Avg Words/Sentence %f
-
-Readability Scores:
-	Flesch-Kincaid Reading Ease  %f
-	Flesch-Kincaid Grade Level   %f
-	Gunning-Fog Score            %f
-	Coleman-Liau Index           %f
-	SMOG Index                   %f
-	Automated Readability Index  %f
-	Dale-Chall Readability Score %f
-
 `,
 		res.Words,
 		res.Sentences,
@@ -39,49 +40,231 @@ Readability Scores:
 		res.Spaces,
 		res.Syllables,
 		res.DifficultWords,
+		res.FunctionWords,
+		res.ContentWords,
+		res.PassiveSentences,
+		res.WordsOfClass(textstats.ProperNoun),
+		res.WordsOfClass(textstats.Acronym),
+		res.WordsOfClass(textstats.Number),
+		res.WordsOfClass(textstats.Compound),
 		res.AverageLettersPerWord(),
 		res.AverageSyllablesPerWord(),
 		res.AverageWordsPerSentence(),
-		res.FleschKincaidReadingEase(),
-		res.FleschKincaidGradeLevel(),
-		res.GunningFogScore(),
-		res.ColemanLiauIndex(),
-		res.SMOGIndex(),
-		res.AutomatedReadabilityIndex(),
-		res.DaleChallReadabilityScore(),
 	)
+	if res.HasFunctionWords() {
+		fmt.Printf("\tLexical Density    %f\n", res.LexicalDensity())
+	}
+	fmt.Println()
+
+	fmt.Println("Readability Scores:")
+	for _, score := range lang.Scores(res) {
+		fmt.Printf("\t%-28s %f\n", score.Name, score.Value)
+	}
+	fmt.Println()
 }
 
-func main() {
+func printFrequencies(title string, freqs []textstats.Frequency) {
+	fmt.Printf("%s:\n", title)
+	for _, freq := range freqs {
+		fmt.Printf("\t%-28s %d\n", freq.Text, freq.Count)
+	}
+	fmt.Println()
+}
+
+func printTopWords(res *textstats.Results, n int, excludeStopWords bool) {
+	printFrequencies("Top Words", res.TopWords(n, excludeStopWords))
+	printFrequencies("Top Bigrams", res.TopBigrams(n, excludeStopWords))
+	printFrequencies("Top Trigrams", res.TopTrigrams(n, excludeStopWords))
+}
+
+// openInput returns stdin when it is piped, otherwise the single file named
+// in args
+func openInput(args []string, usage func()) (string, *os.File) {
 	if !termutil.Isatty(os.Stdin.Fd()) {
-		res, err := textstats.Analyse(os.Stdin)
-		if err != nil {
-			fmt.Println(err)
-			os.Exit(1)
+		return "STDIN", os.Stdin
+	}
+
+	if len(args) != 1 {
+		usage()
+		os.Exit(1)
+	}
+
+	f, err := os.Open(args[0])
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	return args[0], f
+}
+
+// setLanguage sets the language to analyse text in from its ISO 639-1 code,
+// or to detect it if the code is auto. It returns false for an unknown code.
+func setLanguage(code string, opts *textstats.Options) bool {
+	if code == "auto" {
+		opts.DetectLanguage = true
+		return true
+	}
+
+	lang, ok := textstats.LanguageByCode(code)
+	opts.Language = lang
+	return ok
+}
+
+// loadHyphenator reads the hyphenation patterns in a file
+func loadHyphenator(filename string) (*textstats.Hyphenator, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return textstats.LoadHyphenator(f)
+}
+
+func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "lint":
+			lintMain(os.Args[2:])
+			return
+		case "simplify":
+			simplifyMain(os.Args[2:])
+			return
+		case "windows":
+			windowsMain(os.Args[2:])
+			return
+		case "diff":
+			diffMain(os.Args[2:])
+			return
+		case "changed":
+			changedMain(os.Args[2:])
+			return
+		case "history":
+			historyMain(os.Args[2:])
+			return
 		}
+	}
 
-		printStats("STDIN", res)
-		return
+	topWords := flag.Int("top-words", 0, "report the `N` most frequent words, bigrams and trigrams")
+	excludeStopWords := flag.Bool("exclude-stop-words", false, "leave stop words out of the --top-words report")
+	longSentence := flag.Int("long-sentence", 25, "list sentences with more than `N` words")
+	format := flag.String("format", "text", "output `FORMAT`, either text or json")
+	numbers := flag.String("numbers", "ignore", "count numbers as words by `POLICY`: ignore, words or spoken")
+	spellAcronyms := flag.Bool("spell-acronyms", false, "count acronym syllables letter by letter")
+	normalise := flag.String("normalise", "nfc", "normalise words by `POLICY`: nfc, nfkc or none")
+	splitCompounds := flag.Bool("split-compounds", false, "split hyphenated words and contractions into separate words")
+	langCode := flag.String("lang", "en", "analyse text in the language with ISO 639-1 `CODE`: en, de, es, fr, nl, zh, ja or auto to detect it")
+	hyphenation := flag.String("hyphenation", "", "count syllables with the TeX hyphenation patterns in `FILE` rather than the language's rules")
+	flag.Usage = func() {
+		fmt.Println("Usage:", os.Args[0], "[options] [filename]")
+		fmt.Println("      ", os.Args[0], "lint [options] [filenames]")
+		fmt.Println("      ", os.Args[0], "simplify [options] [filename]")
+		fmt.Println("      ", os.Args[0], "windows [options] [filename]")
+		fmt.Println("      ", os.Args[0], "diff [options] old new")
+		fmt.Println("      ", os.Args[0], "changed [options] from [to] [-- paths]")
+		fmt.Println("      ", os.Args[0], "history [options] [paths]")
+		flag.PrintDefaults()
 	}
+	flag.Parse()
 
-	if len(os.Args) == 1 || len(os.Args) > 2 {
-		fmt.Println(os.Args[0])
-		fmt.Println("Usage:", os.Args[0], "[filename]")
+	opts := textstats.Options{
+		WordFrequencies:   *topWords > 0,
+		NGrams:            *topWords > 0,
+		LongSentenceWords: *longSentence,
+		SplitCompounds:    *splitCompounds,
+	}
+
+	switch *numbers {
+	case "ignore":
+		opts.Numbers = textstats.IgnoreNumbers
+	case "words":
+		opts.Numbers = textstats.NumbersAsWords
+	case "spoken":
+		opts.Numbers = textstats.SpokenNumbers
+	default:
+		flag.Usage()
 		os.Exit(1)
 	}
 
-	f, err := os.Open(os.Args[1])
+	switch *normalise {
+	case "nfc":
+		opts.Normalisation = textstats.NormaliseNFC
+	case "nfkc":
+		opts.Normalisation = textstats.NormaliseNFKC
+	case "none":
+		opts.Normalisation = textstats.KeepUnnormalised
+	default:
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	if *spellAcronyms {
+		opts.Acronyms = textstats.SpelledAcronyms
+	}
+
+	if !setLanguage(*langCode, &opts) {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	if *hyphenation != "" {
+		if opts.Language == nil {
+			flag.Usage()
+			os.Exit(1)
+		}
+		h, err := loadHyphenator(*hyphenation)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		opts.Language = h.Language(opts.Language)
+	}
+
+	name, input := openInput(flag.Args(), flag.Usage)
+	defer input.Close()
+
+	data, err := ioutil.ReadAll(input)
 	if err != nil {
 		fmt.Println(err)
 		os.Exit(1)
 	}
-	defer f.Close()
 
-	res, err := textstats.Analyse(f)
+	res, err := textstats.AnalyseWithOptions(bytes.NewReader(data), opts)
 	if err != nil {
 		fmt.Println(err)
 		os.Exit(1)
 	}
 
-	printStats(os.Args[1], res)
+	if det := res.Detection; det != nil && det.Language == nil {
+		detected := "an unknown language"
+		if det.Name != "" {
+			detected = det.Name
+		}
+		fmt.Fprintf(os.Stderr, "warning: %q appears to be in %s, which isn't supported, so it was analysed as English\n", name, detected)
+	}
+	scripts := make([]string, 0, len(res.UnsupportedScripts))
+	for script := range res.UnsupportedScripts {
+		scripts = append(scripts, script)
+	}
+	sort.Strings(scripts)
+	for _, script := range scripts {
+		fmt.Fprintf(os.Stderr, "warning: %q has text in the %s script, which can't be split into words, so its counts are wrong\n", name, script)
+	}
+	lang := res.Language()
+
+	switch *format {
+	case "json":
+		printJSON(name, data, res, lang, *topWords, *excludeStopWords)
+	case "text":
+		printStats(name, res, lang)
+		printDistributions(res)
+		printSentenceLengths(name, data, res)
+		if *topWords > 0 {
+			printTopWords(res, *topWords, *excludeStopWords)
+		}
+	default:
+		flag.Usage()
+		os.Exit(1)
+	}
 }
@@ -0,0 +1,208 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+
+	"github.com/darkliquid/textstats"
+)
+
+// distribution flattens a count map into an array indexed by its keys
+func distribution(counts map[int]int) []int {
+	var max int
+	for k := range counts {
+		if k > max {
+			max = k
+		}
+	}
+
+	dist := make([]int, max+1)
+	for k, v := range counts {
+		if k >= 0 {
+			dist[k] = v
+		}
+	}
+	return dist
+}
+
+func printDistribution(title, unit string, counts map[int]int) {
+	dist := distribution(counts)
+	var max int
+	for _, count := range dist {
+		if count > max {
+			max = count
+		}
+	}
+
+	fmt.Printf("%s:\n", title)
+	for n := 0; n < len(dist); n++ {
+		label := fmt.Sprintf("%d %s", n, unit)
+		fmt.Printf("\t%-14s %5d %s\n", label, dist[n], bar(dist[n], max))
+	}
+	fmt.Println()
+}
+
+func printDistributions(res *textstats.Results) {
+	printDistribution("Word Lengths", "letters", res.WordCountPerLetterCount)
+	printDistribution("Syllables per Word", "syllables", res.WordCountPerSyllableCount)
+}
+
+// jsonFloat is a score that is written as null when it is NaN or infinite,
+// as it is for a text without any words, which JSON can't represent
+type jsonFloat float64
+
+func (f jsonFloat) MarshalJSON() ([]byte, error) {
+	if math.IsNaN(float64(f)) || math.IsInf(float64(f), 0) {
+		return []byte("null"), nil
+	}
+	return json.Marshal(float64(f))
+}
+
+type scoresReport struct {
+	FleschKincaidReadingEase  jsonFloat `json:"flesch_kincaid_reading_ease"`
+	FleschKincaidGradeLevel   jsonFloat `json:"flesch_kincaid_grade_level"`
+	GunningFogScore           jsonFloat `json:"gunning_fog_score"`
+	ColemanLiauIndex          jsonFloat `json:"coleman_liau_index"`
+	SMOGIndex                 jsonFloat `json:"smog_index"`
+	AutomatedReadabilityIndex jsonFloat `json:"automated_readability_index"`
+	DaleChallReadabilityScore jsonFloat `json:"dale_chall_readability_score"`
+}
+
+type frequencyReport struct {
+	Text  string `json:"text"`
+	Count int    `json:"count"`
+}
+
+type longSentenceReport struct {
+	Line   int    `json:"line"`
+	Column int    `json:"column"`
+	Words  int    `json:"words"`
+	Text   string `json:"text"`
+}
+
+// report is the structured form of the statistics printed by printStats and
+// friends. Distributions are arrays indexed by letter, syllable or word count.
+type report struct {
+	Name             string `json:"name"`
+	Words            int    `json:"words"`
+	Sentences        int    `json:"sentences"`
+	Letters          int    `json:"letters"`
+	Punctuation      int    `json:"punctuation"`
+	Spaces           int    `json:"spaces"`
+	Syllables        int    `json:"syllables"`
+	DifficultWords   int    `json:"difficult_words"`
+	FunctionWords    int    `json:"function_words"`
+	ContentWords     int    `json:"content_words"`
+	PassiveSentences int    `json:"passive_sentences"`
+	ProperNouns      int    `json:"proper_nouns"`
+	Acronyms         int    `json:"acronyms"`
+	Numbers          int    `json:"numbers"`
+	CompoundWords    int    `json:"compound_words"`
+	HanCharacters    int    `json:"han_characters,omitempty"`
+	KanaCharacters   int    `json:"kana_characters,omitempty"`
+
+	Language string `json:"language"`
+
+	// UnsupportedScripts counts the letters in scripts whose words can't be
+	// told apart
+	UnsupportedScripts map[string]int `json:"unsupported_scripts,omitempty"`
+
+	// Scores are the English scores, while LanguageScores holds those for
+	// other languages keyed by their IDs
+	Scores         *scoresReport        `json:"scores,omitempty"`
+	LanguageScores map[string]jsonFloat `json:"language_scores,omitempty"`
+
+	WordLengths     []int `json:"word_lengths"`
+	WordSyllables   []int `json:"word_syllables"`
+	SentenceLengths []int `json:"sentence_lengths"`
+
+	LongSentences []longSentenceReport `json:"long_sentences,omitempty"`
+	TopWords      []frequencyReport    `json:"top_words,omitempty"`
+	TopBigrams    []frequencyReport    `json:"top_bigrams,omitempty"`
+	TopTrigrams   []frequencyReport    `json:"top_trigrams,omitempty"`
+}
+
+func frequencies(freqs []textstats.Frequency) []frequencyReport {
+	out := make([]frequencyReport, len(freqs))
+	for i, freq := range freqs {
+		out[i] = frequencyReport{Text: freq.Text, Count: freq.Count}
+	}
+	return out
+}
+
+func printJSON(name string, data []byte, res *textstats.Results, lang textstats.Language, topWords int, excludeStopWords bool) {
+	if err := writeJSON(os.Stdout, name, data, res, lang, topWords, excludeStopWords); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
+
+// writeJSON writes the statistics for a text as a JSON report
+func writeJSON(w io.Writer, name string, data []byte, res *textstats.Results, lang textstats.Language, topWords int, excludeStopWords bool) error {
+	rep := report{
+		Name:             name,
+		Words:            res.Words,
+		Sentences:        res.Sentences,
+		Letters:          res.Letters,
+		Punctuation:      res.Punctuation,
+		Spaces:           res.Spaces,
+		Syllables:        res.Syllables,
+		DifficultWords:   res.DifficultWords,
+		FunctionWords:    res.FunctionWords,
+		ContentWords:     res.ContentWords,
+		PassiveSentences: res.PassiveSentences,
+		ProperNouns:      res.WordsOfClass(textstats.ProperNoun),
+		Acronyms:         res.WordsOfClass(textstats.Acronym),
+		Numbers:          res.WordsOfClass(textstats.Number),
+		CompoundWords:    res.WordsOfClass(textstats.Compound),
+		HanCharacters:    res.HanCharacters,
+		KanaCharacters:   res.KanaCharacters,
+		Language:         lang.Code(),
+
+		UnsupportedScripts: res.UnsupportedScripts,
+
+		WordLengths:     distribution(res.WordCountPerLetterCount),
+		WordSyllables:   distribution(res.WordCountPerSyllableCount),
+		SentenceLengths: distribution(res.SentenceCountPerWordCount),
+	}
+
+	if lang.Code() == textstats.English.Code() {
+		rep.Scores = &scoresReport{
+			FleschKincaidReadingEase:  jsonFloat(res.FleschKincaidReadingEase()),
+			FleschKincaidGradeLevel:   jsonFloat(res.FleschKincaidGradeLevel()),
+			GunningFogScore:           jsonFloat(res.GunningFogScore()),
+			ColemanLiauIndex:          jsonFloat(res.ColemanLiauIndex()),
+			SMOGIndex:                 jsonFloat(res.SMOGIndex()),
+			AutomatedReadabilityIndex: jsonFloat(res.AutomatedReadabilityIndex()),
+			DaleChallReadabilityScore: jsonFloat(res.DaleChallReadabilityScore()),
+		}
+	} else {
+		rep.LanguageScores = make(map[string]jsonFloat)
+		for _, score := range lang.Scores(res) {
+			rep.LanguageScores[score.ID] = jsonFloat(score.Value)
+		}
+	}
+
+	for _, long := range res.LongSentences {
+		line, col := lineColumn(data, long.Start)
+		rep.LongSentences = append(rep.LongSentences, longSentenceReport{
+			Line:   line,
+			Column: col,
+			Words:  long.Words,
+			Text:   string(data[long.Start:long.End]),
+		})
+	}
+
+	if topWords > 0 {
+		rep.TopWords = frequencies(res.TopWords(topWords, excludeStopWords))
+		rep.TopBigrams = frequencies(res.TopBigrams(topWords, excludeStopWords))
+		rep.TopTrigrams = frequencies(res.TopTrigrams(topWords, excludeStopWords))
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(rep)
+}
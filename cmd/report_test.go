@@ -0,0 +1,54 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/darkliquid/textstats"
+	"github.com/stretchr/testify/suite"
+)
+
+type ReportSuite struct {
+	suite.Suite
+}
+
+func (s *ReportSuite) TestJSONEmptyInput() {
+	for _, lang := range []textstats.Language{textstats.English, textstats.German} {
+		res, err := textstats.AnalyseWithOptions(strings.NewReader(""), textstats.Options{Language: lang})
+		s.NoError(err)
+
+		var buf bytes.Buffer
+		s.NoError(writeJSON(&buf, "empty", nil, res, lang, 0, false))
+
+		var rep map[string]interface{}
+		s.NoError(json.Unmarshal(buf.Bytes(), &rep))
+		s.Equal(0.0, rep["words"])
+		if lang == textstats.English {
+			scores := rep["scores"].(map[string]interface{})
+			s.Contains(scores, "flesch_kincaid_grade_level")
+			s.Nil(scores["flesch_kincaid_grade_level"])
+		} else {
+			s.NotEmpty(rep["language_scores"])
+		}
+	}
+}
+
+func (s *ReportSuite) TestJSONFloat() {
+	for _, v := range []float64{1.5, 0, -2} {
+		data, err := json.Marshal(jsonFloat(v))
+		s.NoError(err)
+		expected, _ := json.Marshal(v)
+		s.Equal(expected, data)
+	}
+
+	data, err := json.Marshal([]jsonFloat{jsonFloat(math.NaN()), jsonFloat(math.Inf(1)), jsonFloat(math.Inf(-1))})
+	s.NoError(err)
+	s.Equal("[null,null,null]", string(data))
+}
+
+func TestReportSuite(t *testing.T) {
+	suite.Run(t, new(ReportSuite))
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/darkliquid/textstats"
+)
+
+// sentenceBucket is the number of sentence lengths grouped into each
+// histogram bar
+const sentenceBucket = 5
+
+// barWidth is the length of the longest bar in a histogram
+const barWidth = 40
+
+// bar returns a row of characters proportional to count / max
+func bar(count, max int) string {
+	if max == 0 {
+		return ""
+	}
+	return strings.Repeat("#", (count*barWidth+max-1)/max)
+}
+
+func printSentenceLengths(name string, data []byte, res *textstats.Results) {
+	buckets := make(map[int]int)
+	var keys []int
+	var max int
+	for length, count := range res.SentenceCountPerWordCount {
+		bucket := (length - 1) / sentenceBucket
+		if _, ok := buckets[bucket]; !ok {
+			keys = append(keys, bucket)
+		}
+		buckets[bucket] += count
+		if buckets[bucket] > max {
+			max = buckets[bucket]
+		}
+	}
+	sort.Ints(keys)
+
+	fmt.Printf(`Sentence Lengths:
+	Standard Deviation %f
+	10th Percentile    %d
+	Median             %d
+	90th Percentile    %d
+	Longest            %d
+
+`,
+		res.SentenceLengthStandardDeviation(),
+		res.SentenceLengthPercentile(10),
+		res.SentenceLengthPercentile(50),
+		res.SentenceLengthPercentile(90),
+		res.SentenceLengthPercentile(100),
+	)
+
+	for _, bucket := range keys {
+		label := fmt.Sprintf("%d-%d", bucket*sentenceBucket+1, (bucket+1)*sentenceBucket)
+		fmt.Printf("\t%-8s %5d %s\n", label, buckets[bucket], bar(buckets[bucket], max))
+	}
+	fmt.Println()
+
+	if len(res.LongSentences) == 0 {
+		return
+	}
+
+	fmt.Println("Long Sentences:")
+	for _, long := range res.LongSentences {
+		line, col := lineColumn(data, long.Start)
+		text := strings.Join(strings.Fields(string(data[long.Start:long.End])), " ")
+		fmt.Printf("\t%s:%d:%d: %d words: %s\n", name, line, col, long.Words, text)
+	}
+	fmt.Println()
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/darkliquid/textstats"
+)
+
+func simplifyMain(args []string) {
+	flags := flag.NewFlagSet("simplify", flag.ExitOnError)
+	fix := flags.String("fix", "", "write a copy of the text with every substitution applied to `FILE`")
+	flags.Usage = func() {
+		fmt.Println("Usage:", os.Args[0], "simplify [options] [filename]")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+
+	name, input := openInput(flags.Args(), flags.Usage)
+	defer input.Close()
+
+	data, err := ioutil.ReadAll(input)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	subs, err := textstats.Simplify(bytes.NewReader(data))
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	for _, sub := range subs {
+		line, col := lineColumn(data, sub.Start)
+		fmt.Printf("%s:%d:%d: %q -> %q (grade level %+.2f)\n", name, line, col, sub.Text, sub.Suggestion, sub.GradeLevelChange)
+	}
+
+	if *fix == "" {
+		return
+	}
+
+	rewritten := textstats.Rewrite(string(data), subs)
+	if err := ioutil.WriteFile(*fix, []byte(rewritten), 0644); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	before, _ := textstats.Analyse(bytes.NewReader(data))
+	after, _ := textstats.Analyse(bytes.NewReader([]byte(rewritten)))
+	fmt.Printf("Wrote %s: grade level %.2f -> %.2f\n", *fix, before.FleschKincaidGradeLevel(), after.FleschKincaidGradeLevel())
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+
+	"github.com/darkliquid/textstats"
+)
+
+// score is a readability score that can be charted across windows
+type score struct {
+	name  string
+	title string
+	fn    func(*textstats.Results) float64
+}
+
+// scores are named as in the JSON report
+var scores = []score{
+	{"flesch_kincaid_reading_ease", "Flesch-Kincaid Reading Ease", (*textstats.Results).FleschKincaidReadingEase},
+	{"flesch_kincaid_grade_level", "Flesch-Kincaid Grade Level", (*textstats.Results).FleschKincaidGradeLevel},
+	{"gunning_fog_score", "Gunning-Fog Score", (*textstats.Results).GunningFogScore},
+	{"coleman_liau_index", "Coleman-Liau Index", (*textstats.Results).ColemanLiauIndex},
+	{"smog_index", "SMOG Index", (*textstats.Results).SMOGIndex},
+	{"automated_readability_index", "Automated Readability Index", (*textstats.Results).AutomatedReadabilityIndex},
+	{"dale_chall_readability_score", "Dale-Chall Readability Score", (*textstats.Results).DaleChallReadabilityScore},
+}
+
+// sparkTicks are the bars of a sparkline from lowest to highest
+var sparkTicks = []rune("▁▂▃▄▅▆▇█")
+
+// sparkline draws values as a row of bars scaled between the lowest and
+// highest value
+func sparkline(values []float64) string {
+	if len(values) == 0 {
+		return ""
+	}
+
+	min, max := values[0], values[0]
+	for _, v := range values {
+		if v < min {
+			min = v
+		}
+		if v > max {
+			max = v
+		}
+	}
+
+	line := make([]rune, len(values))
+	for i, v := range values {
+		tick := 0
+		if max > min {
+			tick = int((v - min) / (max - min) * float64(len(sparkTicks)-1))
+		}
+		line[i] = sparkTicks[tick]
+	}
+	return string(line)
+}
+
+func printSparkline(name, unit string, size int, s score, windows []textstats.Window) {
+	values := make([]float64, len(windows))
+	var lowest, highest int
+	for i, w := range windows {
+		values[i] = s.fn(w.Results)
+		if values[i] < values[lowest] {
+			lowest = i
+		}
+		if values[i] > values[highest] {
+			highest = i
+		}
+	}
+
+	fmt.Printf("%s for %q over %d %s windows:\n\n", s.title, name, size, unit)
+	fmt.Printf("\t%s\n\n", sparkline(values))
+	if len(windows) == 0 {
+		return
+	}
+	fmt.Printf("\tLowest  %f at %s:%d\n", values[lowest], name, windows[lowest].Line)
+	fmt.Printf("\tHighest %f at %s:%d\n\n", values[highest], name, windows[highest].Line)
+}
+
+func printWindowsCSV(windows []textstats.Window) {
+	out := csv.NewWriter(os.Stdout)
+
+	header := []string{"window", "line", "start", "end", "words", "sentences"}
+	for _, s := range scores {
+		header = append(header, s.name)
+	}
+	out.Write(header)
+
+	for i, w := range windows {
+		row := []string{
+			strconv.Itoa(i),
+			strconv.Itoa(w.Line),
+			strconv.Itoa(w.Start),
+			strconv.Itoa(w.End),
+			strconv.Itoa(w.Results.Words),
+			strconv.Itoa(w.Results.Sentences),
+		}
+		for _, s := range scores {
+			row = append(row, strconv.FormatFloat(s.fn(w.Results), 'f', -1, 64))
+		}
+		out.Write(row)
+	}
+
+	out.Flush()
+}
+
+func windowsMain(args []string) {
+	flags := flag.NewFlagSet("windows", flag.ExitOnError)
+	size := flags.Int("size", 100, "analyse windows of `N` words or sentences")
+	step := flags.Int("step", 0, "start a window every `N` words or sentences, defaulting to the window size")
+	sentences := flags.Bool("sentences", false, "measure windows in sentences rather than words")
+	scoreName := flags.String("score", "flesch_kincaid_grade_level", "chart the `SCORE` named as in the JSON report")
+	format := flags.String("format", "sparkline", "output `FORMAT`, either sparkline or csv")
+	flags.Usage = func() {
+		fmt.Println("Usage:", os.Args[0], "windows [options] [filename]")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+
+	var chart *score
+	for i := range scores {
+		if scores[i].name == *scoreName {
+			chart = &scores[i]
+		}
+	}
+	if chart == nil || (*format != "sparkline" && *format != "csv") {
+		flags.Usage()
+		os.Exit(1)
+	}
+
+	wopts := textstats.WindowOptions{Size: *size, Step: *step}
+	unit := "word"
+	if *sentences {
+		wopts.Unit = textstats.SentenceWindows
+		unit = "sentence"
+	}
+
+	name, input := openInput(flags.Args(), flags.Usage)
+	defer input.Close()
+
+	data, err := ioutil.ReadAll(input)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	windows, err := textstats.AnalyseWindows(bytes.NewReader(data), wopts, textstats.Options{})
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	if *format == "csv" {
+		printWindowsCSV(windows)
+		return
+	}
+	printSparkline(name, unit, *size, *chart, windows)
+}
@@ -0,0 +1,378 @@
+package textstats
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+// Delta is how a measure or score changed between two versions of a text
+type Delta struct {
+	// ID is the measure's name in snake case, as for Score
+	ID   string
+	Name string
+	Old  float64
+	New  float64
+}
+
+// Change returns how much the value went up, or down if negative
+func (d Delta) Change() float64 {
+	return d.New - d.Old
+}
+
+// SentenceChangeKind is how a sentence changed between two versions of a text
+type SentenceChangeKind int
+
+// Ways a sentence can change
+const (
+	SentenceAdded SentenceChangeKind = iota
+	SentenceRemoved
+	SentenceChanged
+)
+
+var sentenceChangeKindNames = [...]string{
+	"added",
+	"removed",
+	"changed",
+}
+
+// String returns the name of the kind of change
+func (k SentenceChangeKind) String() string {
+	if k < 0 || int(k) >= len(sentenceChangeKindNames) {
+		return "unknown"
+	}
+	return sentenceChangeKindNames[k]
+}
+
+// SentenceChange is a sentence that was added, removed or rewritten between
+// two versions of a text
+type SentenceChange struct {
+	Kind SentenceChangeKind
+
+	// Old and New are where the sentence is in each version, and OldText and
+	// NewText what it says. Old and OldText are empty for an added sentence,
+	// as are New and NewText for a removed one.
+	Old     Span
+	New     Span
+	OldText string
+	NewText string
+
+	// GradeImpact is how much making this change alone to the old text would
+	// move its Flesch-Kincaid grade level. The English formula is used
+	// whatever the language of the text, as a rough guide to which sentences
+	// made it harder to read.
+	GradeImpact float64
+}
+
+// Comparison is how the analysis of a text changed between two versions
+type Comparison struct {
+	// Measures are the changes to the counts and averages
+	Measures []Delta
+
+	// Scores are the changes to the readability scores of the old version's
+	// language
+	Scores []Delta
+
+	// Sentences lists the sentences that changed, in the order they occur.
+	// It is only set by CompareTexts.
+	Sentences []SentenceChange
+}
+
+// measure is a count or average that is compared between texts
+type measure struct {
+	id, name string
+	fn       func(r *Results) float64
+}
+
+// measures are the counts and averages that are compared between texts
+var measures = []measure{
+	{"words", "Words", func(r *Results) float64 { return float64(r.Words) }},
+	{"sentences", "Sentences", func(r *Results) float64 { return float64(r.Sentences) }},
+	{"letters", "Letters", func(r *Results) float64 { return float64(r.Letters) }},
+	{"punctuation", "Punctuation", func(r *Results) float64 { return float64(r.Punctuation) }},
+	{"spaces", "Spaces", func(r *Results) float64 { return float64(r.Spaces) }},
+	{"syllables", "Syllables", func(r *Results) float64 { return float64(r.Syllables) }},
+	{"difficult_words", "Difficult Words", func(r *Results) float64 { return float64(r.DifficultWords) }},
+	{"function_words", "Function Words", func(r *Results) float64 { return float64(r.FunctionWords) }},
+	{"content_words", "Content Words", func(r *Results) float64 { return float64(r.ContentWords) }},
+	{"passive_sentences", "Passive Sentences", func(r *Results) float64 { return float64(r.PassiveSentences) }},
+	{"average_letters_per_word", "Avg Letters/Word", (*Results).AverageLettersPerWord},
+	{"average_syllables_per_word", "Avg Syllables/Word", (*Results).AverageSyllablesPerWord},
+	{"average_words_per_sentence", "Avg Words/Sentence", (*Results).AverageWordsPerSentence},
+}
+
+// Compare returns how every measure and readability score changed from the
+// analysis of an old version of a text, a, to that of a new one, b. Scores
+// are those of the language a was analysed in.
+func Compare(a, b *Results) *Comparison {
+	c := &Comparison{}
+	for _, m := range measures {
+		c.Measures = append(c.Measures, Delta{ID: m.id, Name: m.name, Old: m.fn(a), New: m.fn(b)})
+	}
+
+	lang := a.Language()
+	newScores := lang.Scores(b)
+	for i, score := range lang.Scores(a) {
+		c.Scores = append(c.Scores, Delta{ID: score.ID, Name: score.Name, Old: score.Value, New: newScores[i].Value})
+	}
+	return c
+}
+
+// Score returns the change to the score or measure with the given ID
+func (c *Comparison) Score(id string) (Delta, bool) {
+	for _, deltas := range [][]Delta{c.Scores, c.Measures} {
+		for _, d := range deltas {
+			if d.ID == id {
+				return d, true
+			}
+		}
+	}
+	return Delta{}, false
+}
+
+// ScoreIDs returns the IDs of the readability scores of a language followed
+// by those of the measures, which are the IDs Results.Score and
+// Comparison.Score accept for text in that language
+func ScoreIDs(lang Language) []string {
+	var ids []string
+	for _, score := range lang.Scores(newResults(Options{Language: lang})) {
+		ids = append(ids, score.ID)
+	}
+	for _, m := range measures {
+		ids = append(ids, m.id)
+	}
+	return ids
+}
+
+// Score returns the value of the readability score of the text's language or
+// the measure with the given ID, as used by Comparison.Score
+func (r *Results) Score(id string) (float64, bool) {
+	for _, score := range r.Language().Scores(r) {
+		if score.ID == id {
+			return score.Value, true
+		}
+	}
+	for _, m := range measures {
+		if m.id == id {
+			return m.fn(r), true
+		}
+	}
+	return 0, false
+}
+
+// CompareTexts analyses the versions of a text before and after it was
+// edited and compares them as Compare does. It also lines up the sentences of
+// the two versions to find those that were added, removed or rewritten, and
+// works out how much each of them moved the English Flesch-Kincaid grade
+// level, so the cause of a change in readability can be found.
+func CompareTexts(before, after io.Reader, opts Options) (*Comparison, error) {
+	oldData, err := ioutil.ReadAll(before)
+	if err != nil {
+		return nil, err
+	}
+	newData, err := ioutil.ReadAll(after)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := AnalyseWithOptions(bytes.NewReader(oldData), opts)
+	if err != nil {
+		return nil, err
+	}
+	b, err := AnalyseWithOptions(bytes.NewReader(newData), opts)
+	if err != nil {
+		return nil, err
+	}
+	c := Compare(a, b)
+
+	// sentences are counted in the language the old version was analysed
+	// in, which may have been detected
+	opts.Language = a.Language()
+	oldSentences, err := collectSentences(bytes.NewReader(oldData), opts)
+	if err != nil {
+		return nil, err
+	}
+	newSentences, err := collectSentences(bytes.NewReader(newData), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Sentences = diffSentences(oldData, newData, oldSentences, newSentences)
+	return c, nil
+}
+
+// sentenceTotals are the totals the Flesch-Kincaid grade level is worked
+// out from
+type sentenceTotals struct {
+	words, sentences, syllables int
+}
+
+// add adds a sentence to the totals, or takes it away if sign is -1
+func (t *sentenceTotals) add(s sampleSentence, sign int) {
+	t.sentences += sign
+	for _, word := range s.words {
+		t.words += sign
+		t.syllables += sign * word.syllables
+	}
+}
+
+// grade returns the English Flesch-Kincaid grade level for the totals
+func (t sentenceTotals) grade() float64 {
+	if t.words <= 0 || t.sentences <= 0 {
+		return 0
+	}
+	return (0.39 * float64(t.words) / float64(t.sentences)) + (11.8 * float64(t.syllables) / float64(t.words)) - 15.59
+}
+
+// diffSentences lines up the sentences of two versions of a text, treating
+// sentences as the same if their words and punctuation are, and returns the
+// sentences that differ. Sentences removed and added at the same place are
+// paired up as rewritten.
+func diffSentences(oldData, newData []byte, oldSentences, newSentences []sampleSentence) []SentenceChange {
+	oldTexts := sentenceTexts(oldData, oldSentences)
+	newTexts := sentenceTexts(newData, newSentences)
+
+	var base sentenceTotals
+	for _, s := range oldSentences {
+		base.add(s, 1)
+	}
+
+	var changes []SentenceChange
+	change := func(kind SentenceChangeKind, i, j int) {
+		c := SentenceChange{Kind: kind}
+		totals := base
+		if kind != SentenceAdded {
+			c.Old, c.OldText = oldSentences[i].Span, oldTexts[i]
+			totals.add(oldSentences[i], -1)
+		}
+		if kind != SentenceRemoved {
+			c.New, c.NewText = newSentences[j].Span, newTexts[j]
+			totals.add(newSentences[j], 1)
+		}
+		c.GradeImpact = totals.grade() - base.grade()
+		changes = append(changes, c)
+	}
+
+	// gap reports the sentences between two matching ones
+	gap := func(oldFrom, oldTo, newFrom, newTo int) {
+		for oldFrom < oldTo && newFrom < newTo {
+			change(SentenceChanged, oldFrom, newFrom)
+			oldFrom++
+			newFrom++
+		}
+		for ; oldFrom < oldTo; oldFrom++ {
+			change(SentenceRemoved, oldFrom, 0)
+		}
+		for ; newFrom < newTo; newFrom++ {
+			change(SentenceAdded, 0, newFrom)
+		}
+	}
+
+	var i, j int
+	for _, match := range commonSentences(oldTexts, newTexts) {
+		gap(i, match[0], j, match[1])
+		i, j = match[0]+1, match[1]+1
+	}
+	gap(i, len(oldTexts), j, len(newTexts))
+
+	return changes
+}
+
+// sentenceTexts returns the text of each sentence with its whitespace
+// collapsed, so sentences that have only been wrapped differently match
+func sentenceTexts(data []byte, sentences []sampleSentence) []string {
+	texts := make([]string, len(sentences))
+	for i, s := range sentences {
+		texts[i] = strings.Join(strings.Fields(string(data[s.Start:s.End])), " ")
+	}
+	return texts
+}
+
+// commonSentences returns the indexes of the sentences in the longest common
+// subsequence of two lists of sentences. Sentences the two lists start and end
+// with are matched directly, and the rest with Hirschberg's algorithm, so
+// memory grows with the length of the lists rather than their product.
+func commonSentences(a, b []string) [][2]int {
+	var matches [][2]int
+
+	var prefix int
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		matches = append(matches, [2]int{prefix, prefix})
+		prefix++
+	}
+
+	var suffix int
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	matches = lcsMatches(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix], prefix, prefix, matches)
+	for i := suffix; i > 0; i-- {
+		matches = append(matches, [2]int{len(a) - i, len(b) - i})
+	}
+	return matches
+}
+
+// lcsMatches appends the indexes of the sentences in the longest common
+// subsequence of a and b to matches, offsetting them by ai and bi. It splits a
+// in half and finds where to split b so the halves' subsequences add up to
+// the longest, then does the same for each half.
+func lcsMatches(a, b []string, ai, bi int, matches [][2]int) [][2]int {
+	if len(a) == 0 || len(b) == 0 {
+		return matches
+	}
+	if len(a) == 1 {
+		for j, s := range b {
+			if s == a[0] {
+				return append(matches, [2]int{ai, bi + j})
+			}
+		}
+		return matches
+	}
+
+	mid := len(a) / 2
+	before := lcsLengths(a[:mid], b, false)
+	after := lcsLengths(a[mid:], b, true)
+
+	split, best := 0, -1
+	for k := 0; k <= len(b); k++ {
+		if n := before[k] + after[len(b)-k]; n > best {
+			split, best = k, n
+		}
+	}
+
+	matches = lcsMatches(a[:mid], b[:split], ai, bi, matches)
+	return lcsMatches(a[mid:], b[split:], ai+mid, bi+split, matches)
+}
+
+// lcsLengths returns the lengths of the longest common subsequences of a and
+// each prefix of b, indexed by the prefix's length. If reverse is set the
+// lists are read backwards, giving the lengths for each suffix of b instead.
+func lcsLengths(a, b []string, reverse bool) []int {
+	row := make([]int, len(b)+1)
+	for i := range a {
+		if reverse {
+			i = len(a) - 1 - i
+		}
+
+		// diagonal is row[j-1] from before this sentence of a was added
+		var diagonal int
+		for j := 1; j <= len(b); j++ {
+			k := j - 1
+			if reverse {
+				k = len(b) - j
+			}
+
+			above := row[j]
+			switch {
+			case a[i] == b[k]:
+				row[j] = diagonal + 1
+			case row[j-1] > row[j]:
+				row[j] = row[j-1]
+			}
+			diagonal = above
+		}
+	}
+	return row
+}
@@ -0,0 +1,158 @@
+package textstats
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CompareSuite struct {
+	suite.Suite
+}
+
+func (s *CompareSuite) TestCompare() {
+	a, _ := Analyse(strings.NewReader("The cat sat on the mat."))
+	b, _ := Analyse(strings.NewReader("The cat sat on the mat. The dog ran."))
+	c := Compare(a, b)
+
+	s.Len(c.Measures, len(measures))
+	s.Equal(Delta{ID: "words", Name: "Words", Old: 6, New: 9}, c.Measures[0])
+	s.Equal(3.0, c.Measures[0].Change())
+
+	s.Len(c.Scores, 7)
+	grade, ok := c.Score("flesch_kincaid_grade_level")
+	s.True(ok)
+	s.Equal(a.FleschKincaidGradeLevel(), grade.Old)
+	s.Equal(b.FleschKincaidGradeLevel(), grade.New)
+
+	_, ok = c.Score("unknown")
+	s.False(ok)
+	s.Nil(c.Sentences)
+}
+
+func (s *CompareSuite) TestResultsScore() {
+	res, _ := Analyse(strings.NewReader("The cat sat on the mat."))
+	grade, ok := res.Score("flesch_kincaid_grade_level")
+	s.True(ok)
+	s.Equal(res.FleschKincaidGradeLevel(), grade)
+
+	words, ok := res.Score("words")
+	s.True(ok)
+	s.Equal(6.0, words)
+
+	_, ok = res.Score("unknown")
+	s.False(ok)
+}
+
+func (s *CompareSuite) TestScoreIDs() {
+	ids := ScoreIDs(German)
+	s.Equal([]string{"amstad_reading_ease", "wiener_sachtextformel"}, ids[:2])
+	s.Contains(ids, "words")
+	s.NotContains(ids, "flesch_kincaid_grade_level")
+
+	res, _ := Analyse(strings.NewReader("The cat sat on the mat."))
+	for _, id := range ScoreIDs(English) {
+		_, ok := res.Score(id)
+		s.True(ok, id)
+	}
+}
+
+func (s *CompareSuite) TestCompareTexts() {
+	before := "The cat sat on the mat. It was happy.\nThe dog ran. The end."
+	after := "The cat sat on the mat. It was\nhappy. The considerably overweight canine perambulated. A bird sang. The end."
+
+	c, err := CompareTexts(strings.NewReader(before), strings.NewReader(after), Options{})
+	s.NoError(err)
+
+	a, _ := Analyse(strings.NewReader(before))
+	b, _ := Analyse(strings.NewReader(after))
+	s.Equal(Compare(a, b).Scores, c.Scores)
+
+	s.Len(c.Sentences, 2)
+
+	changed := c.Sentences[0]
+	s.Equal(SentenceChanged, changed.Kind)
+	s.Equal("The dog ran.", changed.OldText)
+	s.Equal(before[changed.Old.Start:changed.Old.End], changed.OldText)
+	s.Equal("The considerably overweight canine perambulated.", changed.NewText)
+	s.Equal(after[changed.New.Start:changed.New.End], changed.NewText)
+	s.Greater(changed.GradeImpact, 5.0)
+
+	added := c.Sentences[1]
+	s.Equal(SentenceAdded, added.Kind)
+	s.Equal("A bird sang.", added.NewText)
+	s.Equal("", added.OldText)
+	s.Less(added.GradeImpact, 0.0)
+
+	c, _ = CompareTexts(strings.NewReader(before), strings.NewReader("The dog ran."), Options{})
+	s.Len(c.Sentences, 3)
+	for _, change := range c.Sentences {
+		s.Equal(SentenceRemoved, change.Kind)
+	}
+	s.Equal("removed", c.Sentences[0].Kind.String())
+}
+
+func (s *CompareSuite) TestCommonSentences() {
+	s.Equal([][2]int{{0, 0}, {2, 1}, {3, 3}}, commonSentences(
+		[]string{"a", "b", "c", "d"},
+		[]string{"a", "c", "x", "d"},
+	))
+	s.Nil(commonSentences(nil, []string{"a"}))
+	s.Equal([][2]int{{0, 0}, {1, 1}}, commonSentences([]string{"a", "b"}, []string{"a", "b"}))
+	s.Equal([][2]int{{0, 1}, {2, 2}, {4, 3}}, commonSentences(
+		[]string{"b", "x", "c", "y", "d"},
+		[]string{"a", "b", "c", "d", "e"},
+	))
+}
+
+func (s *CompareSuite) TestCommonSentencesIsLongest() {
+	// compare against the length of the longest common subsequence worked
+	// out the simple way
+	lcs := func(a, b []string) int {
+		lengths := make([][]int, len(a)+1)
+		for i := range lengths {
+			lengths[i] = make([]int, len(b)+1)
+		}
+		for i := len(a) - 1; i >= 0; i-- {
+			for j := len(b) - 1; j >= 0; j-- {
+				switch {
+				case a[i] == b[j]:
+					lengths[i][j] = lengths[i+1][j+1] + 1
+				case lengths[i+1][j] >= lengths[i][j+1]:
+					lengths[i][j] = lengths[i+1][j]
+				default:
+					lengths[i][j] = lengths[i][j+1]
+				}
+			}
+		}
+		return lengths[0][0]
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+	random := func() []string {
+		list := make([]string, rnd.Intn(30))
+		for i := range list {
+			list[i] = string(rune('a' + rnd.Intn(4)))
+		}
+		return list
+	}
+
+	for n := 0; n < 200; n++ {
+		a, b := random(), random()
+		matches := commonSentences(a, b)
+		s.Len(matches, lcs(a, b))
+		for i, m := range matches {
+			s.Equal(a[m[0]], b[m[1]])
+			if i > 0 {
+				s.Greater(m[0], matches[i-1][0])
+				s.Greater(m[1], matches[i-1][1])
+			}
+		}
+	}
+}
+
+func TestCompareSuite(t *testing.T) {
+	suite.Run(t, new(CompareSuite))
+}
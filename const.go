@@ -3012,3 +3012,1532 @@ var DaleChallWordList = map[string]struct{}{
 	"youth":         struct{}{},
 	"youve":         struct{}{},
 }
+
+// StopWords are common words that carry little meaning on their own and can
+// be excluded from word and phrase frequency reports
+var StopWords = map[string]struct{}{
+	"a":          struct{}{},
+	"about":      struct{}{},
+	"above":      struct{}{},
+	"after":      struct{}{},
+	"again":      struct{}{},
+	"against":    struct{}{},
+	"all":        struct{}{},
+	"am":         struct{}{},
+	"an":         struct{}{},
+	"and":        struct{}{},
+	"any":        struct{}{},
+	"are":        struct{}{},
+	"as":         struct{}{},
+	"at":         struct{}{},
+	"be":         struct{}{},
+	"because":    struct{}{},
+	"been":       struct{}{},
+	"before":     struct{}{},
+	"being":      struct{}{},
+	"below":      struct{}{},
+	"between":    struct{}{},
+	"both":       struct{}{},
+	"but":        struct{}{},
+	"by":         struct{}{},
+	"can":        struct{}{},
+	"could":      struct{}{},
+	"did":        struct{}{},
+	"do":         struct{}{},
+	"does":       struct{}{},
+	"doing":      struct{}{},
+	"down":       struct{}{},
+	"during":     struct{}{},
+	"each":       struct{}{},
+	"few":        struct{}{},
+	"for":        struct{}{},
+	"from":       struct{}{},
+	"further":    struct{}{},
+	"had":        struct{}{},
+	"has":        struct{}{},
+	"have":       struct{}{},
+	"having":     struct{}{},
+	"he":         struct{}{},
+	"her":        struct{}{},
+	"here":       struct{}{},
+	"hers":       struct{}{},
+	"herself":    struct{}{},
+	"him":        struct{}{},
+	"himself":    struct{}{},
+	"his":        struct{}{},
+	"how":        struct{}{},
+	"i":          struct{}{},
+	"if":         struct{}{},
+	"in":         struct{}{},
+	"into":       struct{}{},
+	"is":         struct{}{},
+	"it":         struct{}{},
+	"its":        struct{}{},
+	"itself":     struct{}{},
+	"just":       struct{}{},
+	"me":         struct{}{},
+	"more":       struct{}{},
+	"most":       struct{}{},
+	"my":         struct{}{},
+	"myself":     struct{}{},
+	"no":         struct{}{},
+	"nor":        struct{}{},
+	"not":        struct{}{},
+	"now":        struct{}{},
+	"of":         struct{}{},
+	"off":        struct{}{},
+	"on":         struct{}{},
+	"once":       struct{}{},
+	"only":       struct{}{},
+	"or":         struct{}{},
+	"other":      struct{}{},
+	"our":        struct{}{},
+	"ours":       struct{}{},
+	"ourselves":  struct{}{},
+	"out":        struct{}{},
+	"over":       struct{}{},
+	"own":        struct{}{},
+	"same":       struct{}{},
+	"she":        struct{}{},
+	"should":     struct{}{},
+	"so":         struct{}{},
+	"some":       struct{}{},
+	"such":       struct{}{},
+	"than":       struct{}{},
+	"that":       struct{}{},
+	"the":        struct{}{},
+	"their":      struct{}{},
+	"theirs":     struct{}{},
+	"them":       struct{}{},
+	"themselves": struct{}{},
+	"then":       struct{}{},
+	"there":      struct{}{},
+	"these":      struct{}{},
+	"they":       struct{}{},
+	"this":       struct{}{},
+	"those":      struct{}{},
+	"through":    struct{}{},
+	"to":         struct{}{},
+	"too":        struct{}{},
+	"under":      struct{}{},
+	"until":      struct{}{},
+	"up":         struct{}{},
+	"very":       struct{}{},
+	"was":        struct{}{},
+	"we":         struct{}{},
+	"were":       struct{}{},
+	"what":       struct{}{},
+	"when":       struct{}{},
+	"where":      struct{}{},
+	"which":      struct{}{},
+	"while":      struct{}{},
+	"who":        struct{}{},
+	"whom":       struct{}{},
+	"why":        struct{}{},
+	"will":       struct{}{},
+	"with":       struct{}{},
+	"would":      struct{}{},
+	"you":        struct{}{},
+	"your":       struct{}{},
+	"yours":      struct{}{},
+	"yourself":   struct{}{},
+	"yourselves": struct{}{},
+}
+
+// FunctionWords are English words that carry grammatical rather than lexical
+// meaning: articles, determiners, prepositions, pronouns, auxiliary verbs and
+// conjunctions
+var FunctionWords = map[string]struct{}{
+	"a":          struct{}{},
+	"about":      struct{}{},
+	"above":      struct{}{},
+	"across":     struct{}{},
+	"after":      struct{}{},
+	"against":    struct{}{},
+	"all":        struct{}{},
+	"along":      struct{}{},
+	"although":   struct{}{},
+	"am":         struct{}{},
+	"amid":       struct{}{},
+	"among":      struct{}{},
+	"an":         struct{}{},
+	"and":        struct{}{},
+	"another":    struct{}{},
+	"any":        struct{}{},
+	"anybody":    struct{}{},
+	"anyone":     struct{}{},
+	"anything":   struct{}{},
+	"are":        struct{}{},
+	"around":     struct{}{},
+	"as":         struct{}{},
+	"at":         struct{}{},
+	"be":         struct{}{},
+	"because":    struct{}{},
+	"been":       struct{}{},
+	"before":     struct{}{},
+	"behind":     struct{}{},
+	"being":      struct{}{},
+	"below":      struct{}{},
+	"beneath":    struct{}{},
+	"beside":     struct{}{},
+	"besides":    struct{}{},
+	"between":    struct{}{},
+	"beyond":     struct{}{},
+	"both":       struct{}{},
+	"but":        struct{}{},
+	"by":         struct{}{},
+	"can":        struct{}{},
+	"could":      struct{}{},
+	"despite":    struct{}{},
+	"did":        struct{}{},
+	"do":         struct{}{},
+	"does":       struct{}{},
+	"done":       struct{}{},
+	"down":       struct{}{},
+	"during":     struct{}{},
+	"each":       struct{}{},
+	"either":     struct{}{},
+	"enough":     struct{}{},
+	"every":      struct{}{},
+	"everybody":  struct{}{},
+	"everyone":   struct{}{},
+	"everything": struct{}{},
+	"except":     struct{}{},
+	"few":        struct{}{},
+	"for":        struct{}{},
+	"from":       struct{}{},
+	"had":        struct{}{},
+	"has":        struct{}{},
+	"have":       struct{}{},
+	"having":     struct{}{},
+	"he":         struct{}{},
+	"her":        struct{}{},
+	"hers":       struct{}{},
+	"herself":    struct{}{},
+	"him":        struct{}{},
+	"himself":    struct{}{},
+	"his":        struct{}{},
+	"i":          struct{}{},
+	"if":         struct{}{},
+	"in":         struct{}{},
+	"inside":     struct{}{},
+	"into":       struct{}{},
+	"is":         struct{}{},
+	"it":         struct{}{},
+	"its":        struct{}{},
+	"itself":     struct{}{},
+	"like":       struct{}{},
+	"many":       struct{}{},
+	"may":        struct{}{},
+	"me":         struct{}{},
+	"might":      struct{}{},
+	"mine":       struct{}{},
+	"more":       struct{}{},
+	"most":       struct{}{},
+	"much":       struct{}{},
+	"must":       struct{}{},
+	"my":         struct{}{},
+	"myself":     struct{}{},
+	"near":       struct{}{},
+	"neither":    struct{}{},
+	"no":         struct{}{},
+	"nobody":     struct{}{},
+	"none":       struct{}{},
+	"nor":        struct{}{},
+	"not":        struct{}{},
+	"nothing":    struct{}{},
+	"of":         struct{}{},
+	"off":        struct{}{},
+	"on":         struct{}{},
+	"once":       struct{}{},
+	"one":        struct{}{},
+	"onto":       struct{}{},
+	"or":         struct{}{},
+	"other":      struct{}{},
+	"ought":      struct{}{},
+	"our":        struct{}{},
+	"ours":       struct{}{},
+	"ourselves":  struct{}{},
+	"out":        struct{}{},
+	"outside":    struct{}{},
+	"over":       struct{}{},
+	"past":       struct{}{},
+	"per":        struct{}{},
+	"several":    struct{}{},
+	"shall":      struct{}{},
+	"she":        struct{}{},
+	"should":     struct{}{},
+	"since":      struct{}{},
+	"so":         struct{}{},
+	"some":       struct{}{},
+	"somebody":   struct{}{},
+	"someone":    struct{}{},
+	"something":  struct{}{},
+	"such":       struct{}{},
+	"than":       struct{}{},
+	"that":       struct{}{},
+	"the":        struct{}{},
+	"their":      struct{}{},
+	"theirs":     struct{}{},
+	"them":       struct{}{},
+	"themselves": struct{}{},
+	"then":       struct{}{},
+	"there":      struct{}{},
+	"these":      struct{}{},
+	"they":       struct{}{},
+	"this":       struct{}{},
+	"those":      struct{}{},
+	"though":     struct{}{},
+	"through":    struct{}{},
+	"throughout": struct{}{},
+	"till":       struct{}{},
+	"to":         struct{}{},
+	"toward":     struct{}{},
+	"towards":    struct{}{},
+	"under":      struct{}{},
+	"underneath": struct{}{},
+	"unless":     struct{}{},
+	"unlike":     struct{}{},
+	"until":      struct{}{},
+	"up":         struct{}{},
+	"upon":       struct{}{},
+	"us":         struct{}{},
+	"via":        struct{}{},
+	"was":        struct{}{},
+	"we":         struct{}{},
+	"were":       struct{}{},
+	"what":       struct{}{},
+	"whatever":   struct{}{},
+	"when":       struct{}{},
+	"whenever":   struct{}{},
+	"where":      struct{}{},
+	"whereas":    struct{}{},
+	"wherever":   struct{}{},
+	"whether":    struct{}{},
+	"which":      struct{}{},
+	"whichever":  struct{}{},
+	"while":      struct{}{},
+	"who":        struct{}{},
+	"whoever":    struct{}{},
+	"whom":       struct{}{},
+	"whose":      struct{}{},
+	"will":       struct{}{},
+	"with":       struct{}{},
+	"within":     struct{}{},
+	"without":    struct{}{},
+	"would":      struct{}{},
+	"yet":        struct{}{},
+	"you":        struct{}{},
+	"your":       struct{}{},
+	"yours":      struct{}{},
+	"yourself":   struct{}{},
+	"yourselves": struct{}{},
+}
+
+// GermanFunctionWords are German articles, determiners, prepositions, pronouns,
+// auxiliary verbs and conjunctions
+var GermanFunctionWords = map[string]struct{}{
+	"aber":     struct{}{},
+	"als":      struct{}{},
+	"am":       struct{}{},
+	"an":       struct{}{},
+	"ans":      struct{}{},
+	"auch":     struct{}{},
+	"auf":      struct{}{},
+	"aus":      struct{}{},
+	"bei":      struct{}{},
+	"beim":     struct{}{},
+	"bevor":    struct{}{},
+	"bin":      struct{}{},
+	"bis":      struct{}{},
+	"bist":     struct{}{},
+	"da":       struct{}{},
+	"damit":    struct{}{},
+	"dann":     struct{}{},
+	"darf":     struct{}{},
+	"das":      struct{}{},
+	"dass":     struct{}{},
+	"dein":     struct{}{},
+	"deine":    struct{}{},
+	"dem":      struct{}{},
+	"den":      struct{}{},
+	"denn":     struct{}{},
+	"der":      struct{}{},
+	"des":      struct{}{},
+	"dich":     struct{}{},
+	"die":      struct{}{},
+	"diese":    struct{}{},
+	"diesem":   struct{}{},
+	"diesen":   struct{}{},
+	"dieser":   struct{}{},
+	"dieses":   struct{}{},
+	"dir":      struct{}{},
+	"doch":     struct{}{},
+	"dort":     struct{}{},
+	"du":       struct{}{},
+	"durch":    struct{}{},
+	"dürfen":   struct{}{},
+	"ein":      struct{}{},
+	"eine":     struct{}{},
+	"einem":    struct{}{},
+	"einen":    struct{}{},
+	"einer":    struct{}{},
+	"eines":    struct{}{},
+	"er":       struct{}{},
+	"es":       struct{}{},
+	"euch":     struct{}{},
+	"euer":     struct{}{},
+	"eure":     struct{}{},
+	"für":      struct{}{},
+	"gegen":    struct{}{},
+	"gehabt":   struct{}{},
+	"gewesen":  struct{}{},
+	"geworden": struct{}{},
+	"habe":     struct{}{},
+	"haben":    struct{}{},
+	"habt":     struct{}{},
+	"hast":     struct{}{},
+	"hat":      struct{}{},
+	"hatte":    struct{}{},
+	"hatten":   struct{}{},
+	"hier":     struct{}{},
+	"hinter":   struct{}{},
+	"ich":      struct{}{},
+	"ihm":      struct{}{},
+	"ihnen":    struct{}{},
+	"ihr":      struct{}{},
+	"im":       struct{}{},
+	"in":       struct{}{},
+	"ins":      struct{}{},
+	"ist":      struct{}{},
+	"jene":     struct{}{},
+	"jener":    struct{}{},
+	"jenes":    struct{}{},
+	"kann":     struct{}{},
+	"kannst":   struct{}{},
+	"kein":     struct{}{},
+	"keine":    struct{}{},
+	"keinem":   struct{}{},
+	"keinen":   struct{}{},
+	"keiner":   struct{}{},
+	"keines":   struct{}{},
+	"können":   struct{}{},
+	"mag":      struct{}{},
+	"man":      struct{}{},
+	"mein":     struct{}{},
+	"meine":    struct{}{},
+	"mich":     struct{}{},
+	"mir":      struct{}{},
+	"mit":      struct{}{},
+	"muss":     struct{}{},
+	"musst":    struct{}{},
+	"mögen":    struct{}{},
+	"müssen":   struct{}{},
+	"nach":     struct{}{},
+	"nachdem":  struct{}{},
+	"neben":    struct{}{},
+	"nicht":    struct{}{},
+	"noch":     struct{}{},
+	"nur":      struct{}{},
+	"ob":       struct{}{},
+	"obwohl":   struct{}{},
+	"oder":     struct{}{},
+	"ohne":     struct{}{},
+	"schon":    struct{}{},
+	"sehr":     struct{}{},
+	"seid":     struct{}{},
+	"sein":     struct{}{},
+	"seine":    struct{}{},
+	"seit":     struct{}{},
+	"sich":     struct{}{},
+	"sie":      struct{}{},
+	"sind":     struct{}{},
+	"so":       struct{}{},
+	"soll":     struct{}{},
+	"sollen":   struct{}{},
+	"sondern":  struct{}{},
+	"um":       struct{}{},
+	"und":      struct{}{},
+	"uns":      struct{}{},
+	"unser":    struct{}{},
+	"unsere":   struct{}{},
+	"unter":    struct{}{},
+	"vom":      struct{}{},
+	"von":      struct{}{},
+	"vor":      struct{}{},
+	"war":      struct{}{},
+	"waren":    struct{}{},
+	"weil":     struct{}{},
+	"welche":   struct{}{},
+	"welcher":  struct{}{},
+	"welches":  struct{}{},
+	"wenn":     struct{}{},
+	"werde":    struct{}{},
+	"werden":   struct{}{},
+	"werdet":   struct{}{},
+	"wie":      struct{}{},
+	"will":     struct{}{},
+	"wir":      struct{}{},
+	"wird":     struct{}{},
+	"wirst":    struct{}{},
+	"wollen":   struct{}{},
+	"wurde":    struct{}{},
+	"wurden":   struct{}{},
+	"während":  struct{}{},
+	"zu":       struct{}{},
+	"zum":      struct{}{},
+	"zur":      struct{}{},
+	"zwischen": struct{}{},
+	"über":     struct{}{},
+}
+
+// SpanishFunctionWords are Spanish articles, determiners, prepositions, pronouns,
+// auxiliary verbs and conjunctions
+var SpanishFunctionWords = map[string]struct{}{
+	"a":        struct{}{},
+	"al":       struct{}{},
+	"ante":     struct{}{},
+	"aquel":    struct{}{},
+	"aquella":  struct{}{},
+	"aquellas": struct{}{},
+	"aquellos": struct{}{},
+	"aunque":   struct{}{},
+	"bajo":     struct{}{},
+	"como":     struct{}{},
+	"con":      struct{}{},
+	"contra":   struct{}{},
+	"cual":     struct{}{},
+	"cuales":   struct{}{},
+	"cuando":   struct{}{},
+	"cuyo":     struct{}{},
+	"de":       struct{}{},
+	"debe":     struct{}{},
+	"deben":    struct{}{},
+	"del":      struct{}{},
+	"desde":    struct{}{},
+	"donde":    struct{}{},
+	"durante":  struct{}{},
+	"e":        struct{}{},
+	"el":       struct{}{},
+	"ella":     struct{}{},
+	"ellas":    struct{}{},
+	"ello":     struct{}{},
+	"ellos":    struct{}{},
+	"en":       struct{}{},
+	"entre":    struct{}{},
+	"era":      struct{}{},
+	"eran":     struct{}{},
+	"eres":     struct{}{},
+	"es":       struct{}{},
+	"esa":      struct{}{},
+	"esas":     struct{}{},
+	"ese":      struct{}{},
+	"eso":      struct{}{},
+	"esos":     struct{}{},
+	"esta":     struct{}{},
+	"estaba":   struct{}{},
+	"estado":   struct{}{},
+	"estar":    struct{}{},
+	"estas":    struct{}{},
+	"este":     struct{}{},
+	"esto":     struct{}{},
+	"estos":    struct{}{},
+	"estoy":    struct{}{},
+	"está":     struct{}{},
+	"están":    struct{}{},
+	"fue":      struct{}{},
+	"fueron":   struct{}{},
+	"ha":       struct{}{},
+	"haber":    struct{}{},
+	"habido":   struct{}{},
+	"había":    struct{}{},
+	"hacia":    struct{}{},
+	"han":      struct{}{},
+	"has":      struct{}{},
+	"hasta":    struct{}{},
+	"hay":      struct{}{},
+	"he":       struct{}{},
+	"hemos":    struct{}{},
+	"la":       struct{}{},
+	"las":      struct{}{},
+	"le":       struct{}{},
+	"les":      struct{}{},
+	"lo":       struct{}{},
+	"los":      struct{}{},
+	"me":       struct{}{},
+	"mediante": struct{}{},
+	"menos":    struct{}{},
+	"mi":       struct{}{},
+	"mientras": struct{}{},
+	"mis":      struct{}{},
+	"muy":      struct{}{},
+	"más":      struct{}{},
+	"ni":       struct{}{},
+	"no":       struct{}{},
+	"nos":      struct{}{},
+	"nosotras": struct{}{},
+	"nosotros": struct{}{},
+	"nuestra":  struct{}{},
+	"nuestras": struct{}{},
+	"nuestro":  struct{}{},
+	"nuestros": struct{}{},
+	"o":        struct{}{},
+	"os":       struct{}{},
+	"para":     struct{}{},
+	"pero":     struct{}{},
+	"por":      struct{}{},
+	"porque":   struct{}{},
+	"puede":    struct{}{},
+	"pueden":   struct{}{},
+	"pues":     struct{}{},
+	"que":      struct{}{},
+	"quien":    struct{}{},
+	"quienes":  struct{}{},
+	"se":       struct{}{},
+	"según":    struct{}{},
+	"ser":      struct{}{},
+	"si":       struct{}{},
+	"sido":     struct{}{},
+	"sin":      struct{}{},
+	"sino":     struct{}{},
+	"sobre":    struct{}{},
+	"somos":    struct{}{},
+	"son":      struct{}{},
+	"soy":      struct{}{},
+	"su":       struct{}{},
+	"sus":      struct{}{},
+	"sí":       struct{}{},
+	"también":  struct{}{},
+	"tan":      struct{}{},
+	"te":       struct{}{},
+	"tras":     struct{}{},
+	"tu":       struct{}{},
+	"tus":      struct{}{},
+	"tú":       struct{}{},
+	"u":        struct{}{},
+	"un":       struct{}{},
+	"una":      struct{}{},
+	"unas":     struct{}{},
+	"unos":     struct{}{},
+	"vosotras": struct{}{},
+	"vosotros": struct{}{},
+	"y":        struct{}{},
+	"ya":       struct{}{},
+	"yo":       struct{}{},
+	"él":       struct{}{},
+}
+
+// FrenchFunctionWords are French articles, determiners, prepositions, pronouns,
+// auxiliary verbs and conjunctions
+var FrenchFunctionWords = map[string]struct{}{
+	"a":        struct{}{},
+	"ai":       struct{}{},
+	"après":    struct{}{},
+	"as":       struct{}{},
+	"au":       struct{}{},
+	"aussi":    struct{}{},
+	"aux":      struct{}{},
+	"avaient":  struct{}{},
+	"avait":    struct{}{},
+	"avant":    struct{}{},
+	"avec":     struct{}{},
+	"avez":     struct{}{},
+	"avoir":    struct{}{},
+	"avons":    struct{}{},
+	"car":      struct{}{},
+	"ce":       struct{}{},
+	"celle":    struct{}{},
+	"celles":   struct{}{},
+	"celui":    struct{}{},
+	"ces":      struct{}{},
+	"cet":      struct{}{},
+	"cette":    struct{}{},
+	"ceux":     struct{}{},
+	"chez":     struct{}{},
+	"comme":    struct{}{},
+	"contre":   struct{}{},
+	"d":        struct{}{},
+	"dans":     struct{}{},
+	"de":       struct{}{},
+	"depuis":   struct{}{},
+	"derrière": struct{}{},
+	"des":      struct{}{},
+	"devant":   struct{}{},
+	"doit":     struct{}{},
+	"doivent":  struct{}{},
+	"donc":     struct{}{},
+	"dont":     struct{}{},
+	"du":       struct{}{},
+	"elle":     struct{}{},
+	"elles":    struct{}{},
+	"en":       struct{}{},
+	"entre":    struct{}{},
+	"es":       struct{}{},
+	"est":      struct{}{},
+	"et":       struct{}{},
+	"eu":       struct{}{},
+	"eux":      struct{}{},
+	"il":       struct{}{},
+	"ils":      struct{}{},
+	"je":       struct{}{},
+	"l":        struct{}{},
+	"la":       struct{}{},
+	"laquelle": struct{}{},
+	"le":       struct{}{},
+	"lequel":   struct{}{},
+	"les":      struct{}{},
+	"leur":     struct{}{},
+	"leurs":    struct{}{},
+	"lorsque":  struct{}{},
+	"lui":      struct{}{},
+	"ma":       struct{}{},
+	"mais":     struct{}{},
+	"me":       struct{}{},
+	"mes":      struct{}{},
+	"moi":      struct{}{},
+	"moins":    struct{}{},
+	"mon":      struct{}{},
+	"ne":       struct{}{},
+	"ni":       struct{}{},
+	"nos":      struct{}{},
+	"notre":    struct{}{},
+	"nous":     struct{}{},
+	"on":       struct{}{},
+	"ont":      struct{}{},
+	"or":       struct{}{},
+	"ou":       struct{}{},
+	"où":       struct{}{},
+	"par":      struct{}{},
+	"parce":    struct{}{},
+	"pas":      struct{}{},
+	"pendant":  struct{}{},
+	"peut":     struct{}{},
+	"peuvent":  struct{}{},
+	"plus":     struct{}{},
+	"pour":     struct{}{},
+	"puisque":  struct{}{},
+	"quand":    struct{}{},
+	"que":      struct{}{},
+	"qui":      struct{}{},
+	"quoi":     struct{}{},
+	"sa":       struct{}{},
+	"sans":     struct{}{},
+	"se":       struct{}{},
+	"selon":    struct{}{},
+	"sera":     struct{}{},
+	"seront":   struct{}{},
+	"ses":      struct{}{},
+	"si":       struct{}{},
+	"sommes":   struct{}{},
+	"son":      struct{}{},
+	"sont":     struct{}{},
+	"sous":     struct{}{},
+	"suis":     struct{}{},
+	"sur":      struct{}{},
+	"ta":       struct{}{},
+	"te":       struct{}{},
+	"tes":      struct{}{},
+	"toi":      struct{}{},
+	"ton":      struct{}{},
+	"très":     struct{}{},
+	"tu":       struct{}{},
+	"un":       struct{}{},
+	"une":      struct{}{},
+	"vers":     struct{}{},
+	"vos":      struct{}{},
+	"votre":    struct{}{},
+	"vous":     struct{}{},
+	"y":        struct{}{},
+	"à":        struct{}{},
+	"étaient":  struct{}{},
+	"était":    struct{}{},
+	"été":      struct{}{},
+	"êtes":     struct{}{},
+	"être":     struct{}{},
+}
+
+// DutchFunctionWords are Dutch articles, determiners, prepositions, pronouns,
+// auxiliary verbs and conjunctions
+var DutchFunctionWords = map[string]struct{}{
+	"aan":      struct{}{},
+	"achter":   struct{}{},
+	"al":       struct{}{},
+	"als":      struct{}{},
+	"ben":      struct{}{},
+	"bent":     struct{}{},
+	"bij":      struct{}{},
+	"dan":      struct{}{},
+	"dat":      struct{}{},
+	"de":       struct{}{},
+	"deze":     struct{}{},
+	"die":      struct{}{},
+	"dit":      struct{}{},
+	"door":     struct{}{},
+	"dus":      struct{}{},
+	"een":      struct{}{},
+	"en":       struct{}{},
+	"er":       struct{}{},
+	"geen":     struct{}{},
+	"gehad":    struct{}{},
+	"geweest":  struct{}{},
+	"geworden": struct{}{},
+	"haar":     struct{}{},
+	"had":      struct{}{},
+	"hadden":   struct{}{},
+	"heb":      struct{}{},
+	"hebben":   struct{}{},
+	"hebt":     struct{}{},
+	"heeft":    struct{}{},
+	"heel":     struct{}{},
+	"hem":      struct{}{},
+	"hen":      struct{}{},
+	"het":      struct{}{},
+	"hij":      struct{}{},
+	"hoewel":   struct{}{},
+	"hun":      struct{}{},
+	"ik":       struct{}{},
+	"in":       struct{}{},
+	"is":       struct{}{},
+	"je":       struct{}{},
+	"jij":      struct{}{},
+	"jouw":     struct{}{},
+	"jullie":   struct{}{},
+	"kan":      struct{}{},
+	"kunnen":   struct{}{},
+	"kunt":     struct{}{},
+	"maar":     struct{}{},
+	"mag":      struct{}{},
+	"me":       struct{}{},
+	"met":      struct{}{},
+	"mij":      struct{}{},
+	"mijn":     struct{}{},
+	"moet":     struct{}{},
+	"moeten":   struct{}{},
+	"mogen":    struct{}{},
+	"na":       struct{}{},
+	"naar":     struct{}{},
+	"naast":    struct{}{},
+	"niet":     struct{}{},
+	"nog":      struct{}{},
+	"of":       struct{}{},
+	"om":       struct{}{},
+	"omdat":    struct{}{},
+	"onder":    struct{}{},
+	"ons":      struct{}{},
+	"onze":     struct{}{},
+	"ook":      struct{}{},
+	"op":       struct{}{},
+	"over":     struct{}{},
+	"sinds":    struct{}{},
+	"tegen":    struct{}{},
+	"terwijl":  struct{}{},
+	"toen":     struct{}{},
+	"tot":      struct{}{},
+	"tussen":   struct{}{},
+	"u":        struct{}{},
+	"uit":      struct{}{},
+	"uw":       struct{}{},
+	"van":      struct{}{},
+	"voor":     struct{}{},
+	"want":     struct{}{},
+	"waren":    struct{}{},
+	"was":      struct{}{},
+	"wat":      struct{}{},
+	"we":       struct{}{},
+	"wel":      struct{}{},
+	"welk":     struct{}{},
+	"welke":    struct{}{},
+	"werd":     struct{}{},
+	"werden":   struct{}{},
+	"wie":      struct{}{},
+	"wij":      struct{}{},
+	"wil":      struct{}{},
+	"willen":   struct{}{},
+	"word":     struct{}{},
+	"worden":   struct{}{},
+	"wordt":    struct{}{},
+	"zal":      struct{}{},
+	"ze":       struct{}{},
+	"zeer":     struct{}{},
+	"zij":      struct{}{},
+	"zijn":     struct{}{},
+	"zo":       struct{}{},
+	"zodat":    struct{}{},
+	"zonder":   struct{}{},
+	"zullen":   struct{}{},
+}
+
+// IrregularParticiples are past participles that don't end in -ed, used when
+// detecting passive voice
+var IrregularParticiples = map[string]struct{}{
+	"arisen":     struct{}{},
+	"awoken":     struct{}{},
+	"beaten":     struct{}{},
+	"become":     struct{}{},
+	"begun":      struct{}{},
+	"bent":       struct{}{},
+	"bet":        struct{}{},
+	"bid":        struct{}{},
+	"bitten":     struct{}{},
+	"bled":       struct{}{},
+	"blown":      struct{}{},
+	"bought":     struct{}{},
+	"bred":       struct{}{},
+	"broken":     struct{}{},
+	"brought":    struct{}{},
+	"built":      struct{}{},
+	"burnt":      struct{}{},
+	"caught":     struct{}{},
+	"chosen":     struct{}{},
+	"come":       struct{}{},
+	"cost":       struct{}{},
+	"crept":      struct{}{},
+	"cut":        struct{}{},
+	"dealt":      struct{}{},
+	"done":       struct{}{},
+	"drawn":      struct{}{},
+	"dreamt":     struct{}{},
+	"driven":     struct{}{},
+	"drunk":      struct{}{},
+	"dug":        struct{}{},
+	"eaten":      struct{}{},
+	"fallen":     struct{}{},
+	"fed":        struct{}{},
+	"felt":       struct{}{},
+	"fled":       struct{}{},
+	"flown":      struct{}{},
+	"forbidden":  struct{}{},
+	"forgiven":   struct{}{},
+	"forgotten":  struct{}{},
+	"fought":     struct{}{},
+	"found":      struct{}{},
+	"frozen":     struct{}{},
+	"given":      struct{}{},
+	"gone":       struct{}{},
+	"got":        struct{}{},
+	"gotten":     struct{}{},
+	"ground":     struct{}{},
+	"grown":      struct{}{},
+	"heard":      struct{}{},
+	"held":       struct{}{},
+	"hidden":     struct{}{},
+	"hit":        struct{}{},
+	"hung":       struct{}{},
+	"hurt":       struct{}{},
+	"kept":       struct{}{},
+	"knelt":      struct{}{},
+	"known":      struct{}{},
+	"laid":       struct{}{},
+	"lain":       struct{}{},
+	"leant":      struct{}{},
+	"learnt":     struct{}{},
+	"led":        struct{}{},
+	"left":       struct{}{},
+	"lent":       struct{}{},
+	"let":        struct{}{},
+	"lit":        struct{}{},
+	"lost":       struct{}{},
+	"made":       struct{}{},
+	"meant":      struct{}{},
+	"met":        struct{}{},
+	"mistaken":   struct{}{},
+	"overcome":   struct{}{},
+	"overtaken":  struct{}{},
+	"overthrown": struct{}{},
+	"paid":       struct{}{},
+	"proven":     struct{}{},
+	"put":        struct{}{},
+	"quit":       struct{}{},
+	"read":       struct{}{},
+	"rebuilt":    struct{}{},
+	"ridden":     struct{}{},
+	"risen":      struct{}{},
+	"run":        struct{}{},
+	"rung":       struct{}{},
+	"said":       struct{}{},
+	"seen":       struct{}{},
+	"sent":       struct{}{},
+	"set":        struct{}{},
+	"sewn":       struct{}{},
+	"shaken":     struct{}{},
+	"shed":       struct{}{},
+	"shone":      struct{}{},
+	"shot":       struct{}{},
+	"shown":      struct{}{},
+	"shrunk":     struct{}{},
+	"shut":       struct{}{},
+	"slain":      struct{}{},
+	"slept":      struct{}{},
+	"slid":       struct{}{},
+	"sold":       struct{}{},
+	"sought":     struct{}{},
+	"sped":       struct{}{},
+	"spent":      struct{}{},
+	"spilt":      struct{}{},
+	"split":      struct{}{},
+	"spoken":     struct{}{},
+	"spread":     struct{}{},
+	"sprung":     struct{}{},
+	"spun":       struct{}{},
+	"stolen":     struct{}{},
+	"stood":      struct{}{},
+	"strewn":     struct{}{},
+	"struck":     struct{}{},
+	"stuck":      struct{}{},
+	"stung":      struct{}{},
+	"sung":       struct{}{},
+	"sunk":       struct{}{},
+	"swept":      struct{}{},
+	"swollen":    struct{}{},
+	"sworn":      struct{}{},
+	"swum":       struct{}{},
+	"swung":      struct{}{},
+	"taken":      struct{}{},
+	"taught":     struct{}{},
+	"thought":    struct{}{},
+	"thrown":     struct{}{},
+	"thrust":     struct{}{},
+	"told":       struct{}{},
+	"torn":       struct{}{},
+	"understood": struct{}{},
+	"undertaken": struct{}{},
+	"undone":     struct{}{},
+	"upheld":     struct{}{},
+	"upset":      struct{}{},
+	"wept":       struct{}{},
+	"withdrawn":  struct{}{},
+	"withheld":   struct{}{},
+	"woken":      struct{}{},
+	"won":        struct{}{},
+	"worn":       struct{}{},
+	"wound":      struct{}{},
+	"woven":      struct{}{},
+	"written":    struct{}{},
+}
+
+// WeaselWords are vague qualifiers that weaken a statement without adding
+// information
+var WeaselWords = map[string]struct{}{
+	"actually":      struct{}{},
+	"arguably":      struct{}{},
+	"basically":     struct{}{},
+	"clearly":       struct{}{},
+	"completely":    struct{}{},
+	"extremely":     struct{}{},
+	"fairly":        struct{}{},
+	"huge":          struct{}{},
+	"just":          struct{}{},
+	"largely":       struct{}{},
+	"many":          struct{}{},
+	"mostly":        struct{}{},
+	"obviously":     struct{}{},
+	"quite":         struct{}{},
+	"rather":        struct{}{},
+	"really":        struct{}{},
+	"relatively":    struct{}{},
+	"several":       struct{}{},
+	"significantly": struct{}{},
+	"simply":        struct{}{},
+	"somewhat":      struct{}{},
+	"tiny":          struct{}{},
+	"totally":       struct{}{},
+	"various":       struct{}{},
+	"very":          struct{}{},
+	"virtually":     struct{}{},
+}
+
+// Hedges are phrases that make a statement sound less certain
+var Hedges = map[string]struct{}{
+	"could be":       struct{}{},
+	"i believe":      struct{}{},
+	"i feel":         struct{}{},
+	"i guess":        struct{}{},
+	"i suppose":      struct{}{},
+	"i think":        struct{}{},
+	"in my opinion":  struct{}{},
+	"it appears":     struct{}{},
+	"it seems":       struct{}{},
+	"kind of":        struct{}{},
+	"maybe":          struct{}{},
+	"might be":       struct{}{},
+	"more or less":   struct{}{},
+	"perhaps":        struct{}{},
+	"possibly":       struct{}{},
+	"probably":       struct{}{},
+	"seems to":       struct{}{},
+	"sort of":        struct{}{},
+	"tends to":       struct{}{},
+	"to some extent": struct{}{},
+}
+
+// Cliches are overused phrases that can usually be replaced with something
+// plainer
+var Cliches = map[string]struct{}{
+	"a level playing field":     struct{}{},
+	"all walks of life":         struct{}{},
+	"at the end of the day":     struct{}{},
+	"avoid like the plague":     struct{}{},
+	"better late than never":    struct{}{},
+	"easier said than done":     struct{}{},
+	"few and far between":       struct{}{},
+	"game changer":              struct{}{},
+	"in this day and age":       struct{}{},
+	"last but not least":        struct{}{},
+	"low hanging fruit":         struct{}{},
+	"move the needle":           struct{}{},
+	"only time will tell":       struct{}{},
+	"par for the course":        struct{}{},
+	"read between the lines":    struct{}{},
+	"the bottom line":           struct{}{},
+	"the writing on the wall":   struct{}{},
+	"think outside the box":     struct{}{},
+	"tip of the iceberg":        struct{}{},
+	"when all is said and done": struct{}{},
+}
+
+// WordyPhrases maps long winded phrases to shorter alternatives
+var WordyPhrases = map[string]string{
+	"a large number of":         "many",
+	"a majority of":             "most",
+	"at the present time":       "now",
+	"at this point in time":     "now",
+	"despite the fact that":     "although",
+	"due to the fact that":      "because",
+	"each and every":            "each",
+	"first and foremost":        "first",
+	"for the most part":         "usually",
+	"for the purpose of":        "for",
+	"has the ability to":        "can",
+	"in close proximity to":     "near",
+	"in light of the fact that": "because",
+	"in order to":               "to",
+	"in spite of the fact that": "although",
+	"in the amount of":          "for",
+	"in the event that":         "if",
+	"in the near future":        "soon",
+	"in the process of":         "during",
+	"is able to":                "can",
+	"on a daily basis":          "daily",
+	"prior to":                  "before",
+	"subsequent to":             "after",
+	"the reason why is that":    "because",
+	"until such time as":        "until",
+	"whether or not":            "whether",
+	"with regard to":            "about",
+	"with respect to":           "about",
+}
+
+// PlainLanguage maps complex words and phrases to simpler alternatives, in the
+// style of the US Plain Language guidelines
+var PlainLanguage = map[string]string{
+	"accomplish":            "do",
+	"acquire":               "get",
+	"additional":            "more",
+	"aggregate":             "total",
+	"alleviate":             "ease",
+	"anticipate":            "expect",
+	"apparent":              "clear",
+	"approximately":         "about",
+	"ascertain":             "find out",
+	"assistance":            "help",
+	"commence":              "start",
+	"component":             "part",
+	"comprise":              "include",
+	"concerning":            "about",
+	"consequently":          "so",
+	"constitute":            "make up",
+	"demonstrate":           "show",
+	"designate":             "name",
+	"disseminate":           "spread",
+	"eliminate":             "remove",
+	"endeavor":              "try",
+	"endeavour":             "try",
+	"enumerate":             "list",
+	"equitable":             "fair",
+	"equivalent":            "equal",
+	"evident":               "clear",
+	"expedite":              "speed up",
+	"expenditure":           "spending",
+	"facilitate":            "help",
+	"forfeit":               "lose",
+	"frequently":            "often",
+	"identical":             "same",
+	"implement":             "carry out",
+	"in accordance with":    "under",
+	"in lieu of":            "instead of",
+	"in the absence of":     "without",
+	"incorporate":           "include",
+	"indicate":              "show",
+	"inform":                "tell",
+	"initiate":              "start",
+	"locate":                "find",
+	"magnitude":             "size",
+	"methodology":           "method",
+	"modify":                "change",
+	"necessitate":           "need",
+	"notify":                "tell",
+	"numerous":              "many",
+	"objective":             "goal",
+	"obtain":                "get",
+	"optimal":               "best",
+	"participate":           "take part",
+	"permit":                "let",
+	"portion":               "part",
+	"possess":               "have",
+	"preclude":              "prevent",
+	"proficiency":           "skill",
+	"purchase":              "buy",
+	"regarding":             "about",
+	"relocate":              "move",
+	"remainder":             "rest",
+	"remuneration":          "pay",
+	"reside":                "live",
+	"retain":                "keep",
+	"subsequent":            "later",
+	"subsequently":          "later",
+	"sufficient":            "enough",
+	"terminate":             "end",
+	"transmit":              "send",
+	"transpire":             "happen",
+	"utilisation":           "use",
+	"utilise":               "use",
+	"utilization":           "use",
+	"utilize":               "use",
+	"with the exception of": "except",
+}
+
+// languageNames are the English names of the languages that can be detected
+var languageNames = map[string]string{
+	"en": "English",
+	"de": "German",
+	"es": "Spanish",
+	"fr": "French",
+	"nl": "Dutch",
+	"it": "Italian",
+	"pt": "Portuguese",
+	"sv": "Swedish",
+	"zh": "Chinese",
+	"ja": "Japanese",
+}
+
+// languageSamples are passages of everyday prose in each language that can be
+// detected, from which the n-gram profiles used to detect them are built
+var languageSamples = map[string]string{
+	"en": `The village stood at the edge of the forest, where the road turned towards
+the river. Every morning the children walked to the school on the hill, and
+every evening they came back through the fields with their books under their
+arms. Most of the people who lived there had been born in the same houses as
+their parents, and they knew the names of all their neighbours. When the
+weather was good the old men sat outside the inn and talked about the harvest,
+the price of wheat and the news from the town. Nothing much ever happened, but
+that was what they liked about it. One summer a stranger arrived with a small
+case and a letter for the mayor, and nobody could say what he wanted or how
+long he would stay. He took a room above the bakery and spent his days writing
+in a notebook that he never let anyone see.
+
+The city council met on Tuesday evening to discuss plans for a new library in
+the centre of town. Several residents spoke in favour of the project, saying
+that the old building was too small and could not be heated properly in
+winter. Others were worried about the cost and asked whether the money would
+be better spent on repairing the roads. After a long debate the council agreed
+to ask an architect to prepare a design, which will be shown to the public
+before any final decision is made. The mayor said she hoped that work could
+begin early next year if the government provides the funding it has promised.
+
+To make the bread, mix the flour, salt and yeast in a large bowl and add the
+warm water a little at a time. Knead the dough on a floured table for about ten
+minutes, until it is smooth and elastic, then leave it in a warm place for an
+hour. When it has doubled in size, shape it into a loaf, put it on a baking
+tray and let it rise again while the oven heats up. Bake it for thirty minutes,
+until the crust is golden brown and the loaf sounds hollow when you tap the
+bottom. Let it cool before you cut it, otherwise the inside will be sticky.`,
+
+	"de": `Das Dorf lag am Rand des Waldes, dort wo die Straße zum Fluss hinunter
+führte. Jeden Morgen gingen die Kinder zur Schule auf dem Hügel, und jeden
+Abend kamen sie mit ihren Büchern unter dem Arm über die Felder zurück. Die
+meisten Leute, die dort wohnten, waren in denselben Häusern geboren wie ihre
+Eltern, und sie kannten die Namen aller Nachbarn. Wenn das Wetter schön war,
+saßen die alten Männer vor dem Gasthaus und sprachen über die Ernte, den Preis
+des Weizens und die Neuigkeiten aus der Stadt. Es geschah nicht viel, aber
+gerade das gefiel ihnen. In einem Sommer kam ein Fremder mit einem kleinen
+Koffer und einem Brief für den Bürgermeister, und niemand konnte sagen, was er
+wollte oder wie lange er bleiben würde. Er nahm ein Zimmer über der Bäckerei
+und verbrachte seine Tage damit, in ein Heft zu schreiben, das er niemandem
+zeigte.
+
+Der Stadtrat traf sich am Dienstagabend, um über die Pläne für eine neue
+Bibliothek im Zentrum der Stadt zu beraten. Mehrere Bürger sprachen sich für
+das Projekt aus und sagten, das alte Gebäude sei zu klein und lasse sich im
+Winter nicht richtig heizen. Andere machten sich Sorgen wegen der Kosten und
+fragten, ob das Geld nicht besser für die Reparatur der Straßen ausgegeben
+werden sollte. Nach einer langen Diskussion beschloss der Rat, einen
+Architekten mit einem Entwurf zu beauftragen, der der Öffentlichkeit gezeigt
+werden soll, bevor eine endgültige Entscheidung fällt. Die Bürgermeisterin
+sagte, sie hoffe, dass die Arbeiten Anfang nächsten Jahres beginnen können,
+wenn die Regierung das versprochene Geld zur Verfügung stellt.
+
+Für das Brot mischt man Mehl, Salz und Hefe in einer großen Schüssel und gibt
+nach und nach das warme Wasser dazu. Der Teig wird etwa zehn Minuten auf einem
+bemehlten Tisch geknetet, bis er glatt und elastisch ist, und dann eine Stunde
+an einem warmen Ort stehen gelassen. Wenn er sich verdoppelt hat, formt man
+einen Laib, legt ihn auf ein Backblech und lässt ihn noch einmal gehen,
+während der Ofen heiß wird. Das Brot wird dreißig Minuten gebacken, bis die
+Kruste goldbraun ist und es hohl klingt, wenn man auf den Boden klopft. Man
+sollte es abkühlen lassen, bevor man es schneidet, sonst ist es innen noch
+klebrig.`,
+
+	"es": `El pueblo estaba al borde del bosque, donde el camino giraba hacia el río.
+Cada mañana los niños caminaban hasta la escuela de la colina, y cada tarde
+volvían por los campos con sus libros bajo el brazo. La mayoría de las personas
+que vivían allí habían nacido en las mismas casas que sus padres, y conocían los
+nombres de todos sus vecinos. Cuando hacía buen tiempo los viejos se sentaban
+delante de la posada y hablaban de la cosecha, del precio del trigo y de las
+noticias de la ciudad. Nunca pasaba gran cosa, pero eso era precisamente lo que
+les gustaba. Un verano llegó un forastero con una maleta pequeña y una carta
+para el alcalde, y nadie sabía qué quería ni cuánto tiempo se iba a quedar.
+Alquiló una habitación encima de la panadería y pasaba los días escribiendo en
+un cuaderno que nunca dejaba ver a nadie.
+
+El ayuntamiento se reunió el martes por la tarde para hablar de los planes de
+una nueva biblioteca en el centro de la ciudad. Varios vecinos hablaron a favor
+del proyecto y dijeron que el edificio antiguo era demasiado pequeño y que no
+se podía calentar bien en invierno. Otros estaban preocupados por el coste y
+preguntaron si no sería mejor gastar el dinero en arreglar las calles. Después
+de un largo debate, el consejo decidió pedir a un arquitecto que preparara un
+diseño, que se mostrará a los ciudadanos antes de tomar una decisión final. La
+alcaldesa dijo que esperaba que las obras pudieran empezar a principios del
+año que viene si el gobierno entrega el dinero que ha prometido.
+
+Para hacer el pan, mezcle la harina, la sal y la levadura en un cuenco grande y
+añada el agua tibia poco a poco. Amase la masa sobre una mesa con harina
+durante unos diez minutos, hasta que quede lisa y elástica, y déjela reposar
+una hora en un lugar cálido. Cuando haya doblado su tamaño, dele forma de
+hogaza, póngala en una bandeja y deje que vuelva a subir mientras se calienta
+el horno. Hornéela durante treinta minutos, hasta que la corteza esté dorada y
+suene hueca al golpear la base. Déjela enfriar antes de cortarla, porque si no
+el interior quedará pegajoso.`,
+
+	"fr": `Le village se trouvait à la lisière de la forêt, là où la route tournait
+vers la rivière. Chaque matin les enfants montaient à l'école sur la colline,
+et chaque soir ils revenaient à travers les champs avec leurs livres sous le
+bras. La plupart des gens qui vivaient là étaient nés dans les mêmes maisons
+que leurs parents, et ils connaissaient le nom de tous leurs voisins. Quand il
+faisait beau, les vieux s'asseyaient devant l'auberge et parlaient de la
+récolte, du prix du blé et des nouvelles de la ville. Il ne se passait jamais
+grand-chose, mais c'était justement ce qu'ils aimaient. Un été, un étranger
+arriva avec une petite valise et une lettre pour le maire, et personne ne
+savait ce qu'il voulait ni combien de temps il resterait. Il loua une chambre
+au-dessus de la boulangerie et passait ses journées à écrire dans un carnet
+qu'il ne montrait à personne.
+
+Le conseil municipal s'est réuni mardi soir pour discuter du projet d'une
+nouvelle bibliothèque au centre de la ville. Plusieurs habitants ont pris la
+parole en faveur du projet, en expliquant que l'ancien bâtiment était trop
+petit et qu'on ne pouvait pas bien le chauffer en hiver. D'autres
+s'inquiétaient du coût et ont demandé s'il ne vaudrait pas mieux dépenser
+l'argent pour réparer les routes. Après un long débat, le conseil a décidé de
+demander à un architecte de préparer des plans, qui seront présentés au public
+avant toute décision définitive. La maire a dit qu'elle espérait que les
+travaux pourraient commencer au début de l'année prochaine si le gouvernement
+verse l'argent qu'il a promis.
+
+Pour faire le pain, mélangez la farine, le sel et la levure dans un grand
+saladier et ajoutez l'eau tiède petit à petit. Pétrissez la pâte sur une table
+farinée pendant environ dix minutes, jusqu'à ce qu'elle soit lisse et
+élastique, puis laissez-la reposer une heure dans un endroit chaud. Quand elle
+a doublé de volume, façonnez une miche, posez-la sur une plaque et laissez-la
+lever encore pendant que le four chauffe. Faites-la cuire trente minutes,
+jusqu'à ce que la croûte soit bien dorée et que le pain sonne creux quand on
+tape dessous. Laissez-le refroidir avant de le couper, sinon la mie sera
+collante.`,
+
+	"nl": `Het dorp lag aan de rand van het bos, waar de weg naar de rivier afboog.
+Elke ochtend liepen de kinderen naar de school op de heuvel, en elke avond
+kwamen ze met hun boeken onder de arm door de velden terug. De meeste mensen
+die daar woonden waren in dezelfde huizen geboren als hun ouders, en ze kenden
+de namen van al hun buren. Als het mooi weer was zaten de oude mannen voor de
+herberg en praatten ze over de oogst, de prijs van het graan en het nieuws uit
+de stad. Er gebeurde nooit veel, maar dat was juist wat ze er zo prettig aan
+vonden. Op een zomer kwam er een vreemdeling met een kleine koffer en een brief
+voor de burgemeester, en niemand wist wat hij wilde of hoe lang hij zou
+blijven. Hij huurde een kamer boven de bakkerij en bracht zijn dagen door met
+schrijven in een schrift dat hij aan niemand liet zien.
+
+De gemeenteraad kwam dinsdagavond bij elkaar om te praten over de plannen voor
+een nieuwe bibliotheek in het centrum van de stad. Verschillende inwoners
+spraken zich uit voor het project en zeiden dat het oude gebouw te klein was en
+in de winter niet goed verwarmd kon worden. Anderen maakten zich zorgen over de
+kosten en vroegen of het geld niet beter kon worden besteed aan het repareren
+van de wegen. Na een lang debat besloot de raad een architect te vragen een
+ontwerp te maken, dat aan het publiek zal worden getoond voordat er een
+definitief besluit wordt genomen. De burgemeester zei dat ze hoopte dat het
+werk begin volgend jaar kan beginnen als de regering het beloofde geld geeft.
+
+Om het brood te maken, meng je de bloem, het zout en de gist in een grote kom
+en voeg je beetje bij beetje het lauwe water toe. Kneed het deeg ongeveer tien
+minuten op een met bloem bestoven tafel, tot het glad en elastisch is, en laat
+het dan een uur op een warme plek staan. Als het twee keer zo groot is
+geworden, vorm je er een brood van, leg je het op een bakplaat en laat je het
+opnieuw rijzen terwijl de oven opwarmt. Bak het dertig minuten, tot de korst
+goudbruin is en het brood hol klinkt als je op de onderkant klopt. Laat het
+afkoelen voordat je het snijdt, anders is de binnenkant nog kleverig.`,
+
+	"it": `Il paese si trovava al margine del bosco, dove la strada girava verso il
+fiume. Ogni mattina i bambini salivano alla scuola sulla collina, e ogni sera
+tornavano attraverso i campi con i libri sotto il braccio. La maggior parte
+delle persone che vivevano lì erano nate nelle stesse case dei loro genitori, e
+conoscevano il nome di tutti i vicini. Quando faceva bel tempo i vecchi si
+sedevano davanti all'osteria e parlavano del raccolto, del prezzo del grano e
+delle notizie della città. Non succedeva mai molto, ma era proprio questo che
+piaceva loro. Un'estate arrivò uno straniero con una piccola valigia e una
+lettera per il sindaco, e nessuno sapeva che cosa volesse né quanto tempo
+sarebbe rimasto. Prese una camera sopra il forno e passava le giornate a
+scrivere in un quaderno che non faceva mai vedere a nessuno.
+
+Il consiglio comunale si è riunito martedì sera per discutere i progetti di una
+nuova biblioteca nel centro della città. Diversi cittadini hanno parlato a
+favore del progetto, dicendo che il vecchio edificio era troppo piccolo e che
+d'inverno non si riusciva a riscaldarlo bene. Altri erano preoccupati per i
+costi e hanno chiesto se non fosse meglio spendere i soldi per riparare le
+strade. Dopo un lungo dibattito, il consiglio ha deciso di chiedere a un
+architetto di preparare un progetto, che sarà mostrato al pubblico prima di
+prendere una decisione definitiva. La sindaca ha detto di sperare che i lavori
+possano cominciare all'inizio dell'anno prossimo, se il governo darà i soldi
+che ha promesso.
+
+Per fare il pane, mescolate la farina, il sale e il lievito in una ciotola
+grande e aggiungete l'acqua tiepida poco alla volta. Impastate sopra un tavolo
+infarinato per circa dieci minuti, finché l'impasto non diventa liscio ed
+elastico, poi lasciatelo riposare per un'ora in un luogo caldo. Quando è
+raddoppiato, dategli la forma di una pagnotta, mettetela su una teglia e
+lasciatela lievitare di nuovo mentre il forno si scalda. Cuocetela per trenta
+minuti, finché la crosta non è ben dorata e il pane suona vuoto quando si batte
+sul fondo. Lasciatelo raffreddare prima di tagliarlo, altrimenti l'interno
+resterà appiccicoso.`,
+
+	"pt": `A aldeia ficava na orla da floresta, onde a estrada virava em direção ao
+rio. Todas as manhãs as crianças subiam até à escola no alto da colina, e todas
+as tardes voltavam pelos campos com os livros debaixo do braço. A maior parte
+das pessoas que ali viviam tinham nascido nas mesmas casas que os seus pais, e
+conheciam os nomes de todos os vizinhos. Quando o tempo estava bom os velhos
+sentavam-se à porta da estalagem e conversavam sobre a colheita, o preço do
+trigo e as notícias da cidade. Nunca acontecia grande coisa, mas era isso mesmo
+que eles gostavam. Num verão chegou um forasteiro com uma mala pequena e uma
+carta para o presidente da câmara, e ninguém sabia o que ele queria nem quanto
+tempo ia ficar. Alugou um quarto por cima da padaria e passava os dias a
+escrever num caderno que nunca mostrava a ninguém.
+
+A câmara municipal reuniu-se na terça-feira à noite para discutir os planos de
+uma nova biblioteca no centro da cidade. Vários moradores falaram a favor do
+projeto, dizendo que o edifício antigo era pequeno demais e que não se
+conseguia aquecê-lo bem no inverno. Outros estavam preocupados com o custo e
+perguntaram se não seria melhor gastar o dinheiro na reparação das estradas.
+Depois de um longo debate, a câmara decidiu pedir a um arquiteto que
+preparasse um projeto, que será mostrado ao público antes de ser tomada uma
+decisão final. A presidente da câmara disse que esperava que as obras
+pudessem começar no início do próximo ano, se o governo entregar o dinheiro que
+prometeu.
+
+Para fazer o pão, misture a farinha, o sal e o fermento numa tigela grande e
+junte a água morna aos poucos. Amasse a massa numa mesa polvilhada com farinha
+durante cerca de dez minutos, até ficar lisa e elástica, e depois deixe-a
+descansar uma hora num sítio quente. Quando tiver duplicado de tamanho, dê-lhe
+a forma de um pão, coloque-o num tabuleiro e deixe-o levedar outra vez
+enquanto o forno aquece. Coza-o durante trinta minutos, até a côdea estar
+dourada e o pão soar oco quando se bate no fundo. Deixe-o arrefecer antes de o
+cortar, senão o miolo fica pegajoso.`,
+
+	"sv": `Byn låg vid skogens kant, där vägen svängde ner mot älven. Varje morgon gick
+barnen till skolan uppe på kullen, och varje kväll kom de tillbaka över
+fälten med sina böcker under armen. De flesta som bodde där var födda i samma
+hus som sina föräldrar, och de kände till namnen på alla sina grannar. När
+vädret var vackert satt de gamla männen utanför värdshuset och pratade om
+skörden, priset på vete och nyheterna från staden. Det hände aldrig särskilt
+mycket, men det var just det som de tyckte om. En sommar kom en främling med
+en liten väska och ett brev till borgmästaren, och ingen kunde säga vad han
+ville eller hur länge han tänkte stanna. Han hyrde ett rum ovanför bageriet och
+tillbringade dagarna med att skriva i ett häfte som han aldrig visade för
+någon.
+
+Kommunfullmäktige sammanträdde på tisdagskvällen för att diskutera planerna på
+ett nytt bibliotek i centrum av staden. Flera invånare talade för projektet och
+sade att den gamla byggnaden var för liten och inte gick att värma ordentligt
+på vintern. Andra var oroliga för kostnaden och frågade om pengarna inte borde
+användas till att laga vägarna i stället. Efter en lång debatt beslutade
+fullmäktige att be en arkitekt ta fram ett förslag, som ska visas för
+allmänheten innan något slutligt beslut fattas. Kommunalrådet sade att hon
+hoppades att arbetet kan börja i början av nästa år om regeringen betalar ut
+de pengar den har lovat.
+
+För att baka brödet blandar du mjölet, saltet och jästen i en stor skål och
+häller i det ljumma vattnet lite i taget. Knåda degen på ett mjölat bord i
+ungefär tio minuter, tills den är slät och elastisk, och låt den sedan jäsa en
+timme på ett varmt ställe. När den har blivit dubbelt så stor formar du en
+limpa, lägger den på en plåt och låter den jäsa igen medan ugnen blir varm.
+Grädda brödet i trettio minuter, tills skorpan är gyllenbrun och det låter
+ihåligt när du knackar på undersidan. Låt det svalna innan du skär det, annars
+blir det kladdigt inuti.`,
+
+	"zh": `村子在森林的边上，路在那里转向河边。每天早上，孩子们走到山上的学校去，每天
+晚上，他们夹着书穿过田野回家。住在那里的大多数人都是在父母出生的房子里出生的，他们知道所有
+邻居的名字。天气好的时候，老人们坐在客栈外面，谈论收成、小麦的价格和城里的消息。那里从来没
+有发生过什么大事，但这正是他们喜欢的地方。有一年夏天，一个陌生人带着一个小箱子和一封给村长
+的信来了，没有人知道他想要什么，也不知道他会住多久。他在面包店楼上租了一个房间，每天都在一
+个从不让别人看的笔记本上写东西。
+
+市议会星期二晚上开会，讨论在市中心建一座新图书馆的计划。几位居民发言支持这个项目，
+说旧的大楼太小了，冬天也没法好好取暖。另一些人担心费用，问这笔钱是不是应该用来修路。
+经过长时间的讨论，议会决定请一位建筑师做一个设计，在作出最后决定以前先给大家看。
+市长说，如果政府按照承诺拨款，她希望明年年初就能开工。
+
+做面包的时候，先把面粉、盐和酵母放在一个大碗里拌匀，再一点一点地加温水。在撒了面粉的
+桌子上揉面大约十分钟，揉到面团光滑有弹性，然后放在温暖的地方发一个小时。等面团发到
+两倍大，把它做成面包的样子，放在烤盘上，一边预热烤箱一边让它再发一次。烤三十分钟，
+一直烤到外皮金黄，敲底部的时候听起来是空的。切之前要让它凉下来，不然里面会很粘。`,
+
+	"ja": `村は森のはずれにあり、道はそこで川の方へ曲がっていた。毎朝、子どもたちは丘の
+上の学校まで歩いて行き、毎晩、本を抱えて畑を通って帰ってきた。そこに住んでいる人のほとんど
+は、親と同じ家で生まれ、近所の人の名前をみんな知っていた。天気のいい日には、老人たちは宿屋の
+前に座って、収穫のことや小麦の値段や町の知らせについて話した。大したことは何も起こらなかった
+が、それこそが彼らの気に入っているところだった。ある夏、一人の見知らぬ男が小さなかばんと村長
+あての手紙を持ってやって来たが、彼が何を望んでいるのか、どのくらいいるつもりなのか、誰にもわ
+からなかった。彼はパン屋の二階に部屋を借り、誰にも見せないノートに毎日何かを書いて過ごした。
+
+市議会は火曜日の夜に開かれ、町の中心に新しい図書館を建てる計画について話し合った。
+何人かの住民は計画に賛成し、古い建物は狭すぎて冬にはきちんと暖房ができないと述べた。
+費用を心配する人もいて、そのお金は道路の修理に使った方がよいのではないかと質問した。
+長い議論の末、議会は建築家に設計を頼み、最終的に決める前にそれを市民に公開することにした。
+市長は、政府が約束した資金を出してくれれば、来年の初めには工事を始めたいと話した。
+
+パンを作るには、大きなボウルに小麦粉と塩とイーストを入れて混ぜ、ぬるま湯を少しずつ
+加えます。粉をふった台の上で生地を十分ほどこね、なめらかで弾力が出たら、暖かい場所で
+一時間休ませます。二倍の大きさにふくらんだら形を整えて天板にのせ、オーブンを温めている
+間にもう一度発酵させます。三十分ほど焼き、表面がこんがりと色づいて、底をたたくと軽い
+音がしたら焼き上がりです。切る前に冷ましておかないと、中がべたつきます。`,
+}
+
+// CommonHanCharacters are the 1,170 or so most frequent characters in modern
+// Chinese, which make up the great majority of any text
+var CommonHanCharacters = runeSet(`
+的一是不了在人有我他这个们中来上大为和国地到以说时要就出会可也你对生能而子那得于着下自之年过发后作里用道行所然家
+种事成方多经么去法学如都同现当没动面起看定天分还进好小部其些主样理心她本前开但因只从想实日军者意无力它与长把机十民
+第公此已工使情明性知全三又关点正业外将两高间由问很最重并物手应战向头文体政美相见被利什二等产或新己制身果加西斯月话
+合回特代内信表化老给世位次度门任常先海通教儿原东声提立及比员解水名真论处走义各入几口认条平系气题活尔更别打女变四神
+总何电数安少报才结反受目太量再感建务做接必场件计管期市直德资命山金指克许统区保至队形社便空决治展马科司五基眼书非则
+听白却界达光放强即像难且权思王象完设式色路记南品住告类求据程北边死张该交规万取拉格望觉术领共确传师观清今切院让识候
+带导争运笑飞风步改收根干造言联持组每济车亲极林服快办议往元英士证近失转夫令准布始怎呢存未远叫台单影具罗字爱击流备兵
+连调深商算质团集百需价花党华城石级整府离况亚请技际约示复病息究线似官火断精满支视消越器容照须九增研写称企八功吗包片
+史委乎查轻易早曾除农找装广显吧阿李标谈吃图念六引历首医局突专费号尽另周较注语仅考落青随选列武红响虽推势参希古众构房
+半节土投某案黑维革划敌致陈律足态护七兴派孩验责营星够章音跟志底站严巴例防族供效续施留讲型料终答紧黄绝奇察母京段依批
+群项故按河米围江织害斗双境客纪采举杀攻父苏密低朝友诉止细愿千值仍男钱破网热助倒育属坐帝限船脸职速刻乐否刚威毛状率甚
+独球般普怕弹校苦创假久错承印晚兰试股拿脑预谁益阳若哪微尼继送急血惊伤素药适波夜省初喜卫源食险待述陆习置居劳财环排福
+纳欢雷警获模充负云停木游龙树疑层冷洲冲射略范竟句室异激汉村哈策演简卡罪判担州静退既衣您宗积余痛检差富灵协角占配征修
+皮挥胜降阶审沉坚善妈刘读啊超免压银买皇养伊怀执副乱抗犯追帮宣佛岁航优怪香著田铁控税左右份穿艺背阵草脚概恶块顿敢守酒
+岛托央户烈洋哥索胡款靠评版宝座释景顾弟货互付伯慢欧换闻危忙核暗姐介坏讨丽良序升监临亮露永呼味野架域沙掉括舰鱼杂误湾
+吉减编楚肯测败屋跑梦散温困剑渐封救贵枪缺楼县尚毫移娘朋画班智亦耳恩短掌恐遗固席松秘谢鲁遇康虑幸均销钟诗藏赶剧票损忽
+巨炮旧端探湖录叶春乡附吸予礼港雨呀板庭妇归睛饭额含顺输摇招婚脱补谓督毒油疗旅泽材灭逐莫笔亡鲜词圣择寻厂睡博勒烟授诺
+伦岸奥唐卖俄炸载洛健堂旁宫喝借君禁阴园谋宋避抓荣姑孙逃牙束跳顶玉镇雪午练迫爷篇肉嘴馆遍凡础洞卷坦牛宁纸诸训私庄祖丝
+翻暴森塔默握戏隐熟骨访弱蒙歌店鬼软典欲萨伙遭盘爸扩盖弄雄稳忘亿刺拥徒姆杨齐赛趣曲刀床迎冰虚玩析窗醒妻透购替塞努休虎
+扬途侵刑绿兄迅套贸毕唯谷轮库迹尤竞街促延震弃甲伟麻川申缓潜闪售灯针哲络抵朱埃抱鼓植纯夏忍页杰筑折郑贝尊吴秀混臣雅振
+染盛怒舞圆搞狂措姓残秋培迷诚宽宇猛摆梅毁伸摩盟末乃悲拍丁赵横稿
+`)
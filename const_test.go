@@ -1,8 +1,15 @@
 package textstats
 
+import "strings"
+
 const (
 	hw    = "Hello World, this is absolutely excellent"
 	qbf   = "The quick brown fox jumps over the lazy dog"
+	rep   = "the cat sat on the mat and the dog sat on the log"
+	lint  = "I think we really need to act quickly in order to win. At the end of the day, it seems the bottom line is very clear."
+	plain = "Utilize the remainder of the funds. We will commence in accordance with the plan, and TERMINATE later."
+	nums  = "I don't think it's well-known that COVID-19 cost $5 in 2024, about 3.14 units. The FBI agreed."
+	pasv  = "The cake was eaten by the dog. It is red. The letters were not written quickly, but they were quickly written and sent! He is being followed."
 	lorem = `Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do
 			eiusmod tempor incididunt ut labore et dolore magna aliqua. Ut enim
 			ad minim veniam, quis nostrud exercitation ullamco laboris nisi ut
@@ -11,3 +18,10 @@ const (
 			pariatur. Excepteur sint occaecat cupidatat non proident, sunt in
 			culpa qui officia deserunt mollit anim id est laborum.`
 )
+
+// benchCorpus is about a megabyte of text built from the test texts, for
+// measuring throughput
+var benchCorpus = func() []byte {
+	texts := strings.Join([]string{hw, qbf, rep, lint, plain, nums, pasv, lorem}, ".\n\n") + ".\n\n"
+	return []byte(strings.Repeat(texts, (1<<20)/len(texts)+1))
+}()
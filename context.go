@@ -0,0 +1,183 @@
+package textstats
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// TruncationReason describes why an analysis stopped before the end of the
+// text
+type TruncationReason int
+
+// Reasons an analysis may stop early
+const (
+	// TruncatedByContext means the analysis' context was cancelled or its
+	// deadline passed
+	TruncatedByContext TruncationReason = iota
+
+	// TruncatedByMaxBytes means the text is longer than Options.MaxBytes
+	TruncatedByMaxBytes
+
+	// TruncatedByMaxWords means the text has more words than
+	// Options.MaxWords
+	TruncatedByMaxWords
+)
+
+// TruncatedError is returned along with the partial Results of an analysis
+// that stopped before the end of the text
+type TruncatedError struct {
+	Reason TruncationReason
+
+	// Offset is the byte offset in the text where the analysis stopped
+	Offset int
+
+	// Err is the context's error when the analysis was cancelled
+	Err error
+}
+
+// Error describes why and where the analysis stopped
+func (e *TruncatedError) Error() string {
+	switch e.Reason {
+	case TruncatedByMaxBytes:
+		return fmt.Sprintf("textstats: analysis truncated at byte %d: byte limit reached", e.Offset)
+	case TruncatedByMaxWords:
+		return fmt.Sprintf("textstats: analysis truncated at byte %d: word limit reached", e.Offset)
+	}
+	return fmt.Sprintf("textstats: analysis truncated at byte %d: %v", e.Offset, e.Err)
+}
+
+// Unwrap returns the context's error when the analysis was cancelled, so
+// errors.Is(err, context.DeadlineExceeded) works as expected
+func (e *TruncatedError) Unwrap() error {
+	return e.Err
+}
+
+// analyseBufferSize is how much of the text is read at a time
+const analyseBufferSize = 32 * 1024
+
+// AnalyseContext scans a reader and outputs an analysis like
+// AnalyseWithOptions, stopping early if ctx is done or the text goes over
+// Options.MaxBytes or Options.MaxWords. When it stops early it returns the
+// Results for the text analysed so far along with a *TruncatedError.
+//
+// A Read that blocks can't be interrupted, so when ctx is cancelled during
+// one AnalyseContext returns straight away and leaves the Read to finish in
+// the background.
+func AnalyseContext(ctx context.Context, r io.Reader, opts Options) (res *Results, err error) {
+	if ctx.Done() != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+	}
+
+	a := NewAnalyser(opts)
+	cr := newChunkReader(ctx, r)
+	for {
+		p, rerr := cr.next()
+		if _, werr := a.write(p); werr != nil {
+			break
+		}
+
+		if rerr != nil {
+			switch {
+			case rerr == ctx.Err():
+				a.truncate(rerr)
+			case rerr != io.EOF:
+				err = rerr
+			}
+			break
+		}
+	}
+
+	res = a.finish()
+	if a.err != nil {
+		err = a.err
+	}
+	return
+}
+
+// chunk is a piece of the text read by a chunkReader
+type chunk struct {
+	p   []byte
+	err error
+}
+
+// chunkReader reads a text a chunk at a time. When the analysis can be
+// cancelled it reads in the background, so a Read that blocks doesn't stop
+// the analysis noticing it has been cancelled.
+type chunkReader struct {
+	ctx context.Context
+	r   io.Reader
+	buf []byte
+
+	chunks chan chunk
+	free   chan []byte
+}
+
+// newChunkReader returns a chunkReader for r, which stops reading when ctx is
+// done
+func newChunkReader(ctx context.Context, r io.Reader) *chunkReader {
+	cr := &chunkReader{ctx: ctx, r: r}
+	if ctx.Done() == nil {
+		cr.buf = make([]byte, analyseBufferSize)
+		return cr
+	}
+
+	// two buffers let the next chunk be read while the last is analysed
+	cr.chunks = make(chan chunk)
+	cr.free = make(chan []byte, 2)
+	cr.free <- make([]byte, analyseBufferSize)
+	cr.free <- make([]byte, analyseBufferSize)
+	go cr.run()
+	return cr
+}
+
+// run reads chunks in the background until the text ends or ctx is done
+func (cr *chunkReader) run() {
+	for {
+		var buf []byte
+		select {
+		case buf = <-cr.free:
+		case <-cr.ctx.Done():
+			return
+		}
+
+		n, err := cr.r.Read(buf)
+		select {
+		case cr.chunks <- chunk{buf[:n], err}:
+		case <-cr.ctx.Done():
+			return
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// next returns the next chunk of the text and any error from reading it, or
+// the context's error once it is done. The chunk is only valid until the next
+// call.
+func (cr *chunkReader) next() ([]byte, error) {
+	if cr.chunks == nil {
+		n, err := cr.r.Read(cr.buf)
+		return cr.buf[:n], err
+	}
+
+	if cr.buf != nil {
+		cr.free <- cr.buf
+		cr.buf = nil
+	}
+
+	if err := cr.ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	select {
+	case c := <-cr.chunks:
+		cr.buf = c.p[:cap(c.p)]
+		return c.p, c.err
+	case <-cr.ctx.Done():
+		return nil, cr.ctx.Err()
+	}
+}
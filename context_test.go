@@ -0,0 +1,72 @@
+package textstats
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ContextSuite struct {
+	suite.Suite
+}
+
+func (s *ContextSuite) TestMaxWords() {
+	res, err := AnalyseContext(context.Background(), strings.NewReader(pasv), Options{MaxWords: 10})
+	var truncated *TruncatedError
+	s.Require().True(errors.As(err, &truncated))
+	s.Equal(TruncatedByMaxWords, truncated.Reason)
+	s.Equal(42, truncated.Offset)
+	s.Equal("textstats: analysis truncated at byte 42: word limit reached", err.Error())
+	s.Equal(10, res.Words)
+	s.Equal(2, res.Sentences)
+	s.Equal(map[int]int{7: 1, 3: 1}, res.SentenceCountPerWordCount)
+
+	res, err = AnalyseWithOptions(strings.NewReader(qbf), Options{MaxWords: 9})
+	s.NoError(err)
+	s.Equal(9, res.Words)
+}
+
+func (s *ContextSuite) TestMaxBytes() {
+	res, err := AnalyseWithOptions(strings.NewReader(hw), Options{MaxBytes: 11})
+	s.Equal(&TruncatedError{Reason: TruncatedByMaxBytes, Offset: 11}, err)
+	s.Equal(2, res.Words)
+	s.Equal(10, res.Letters)
+
+	res, err = AnalyseWithOptions(strings.NewReader(hw), Options{MaxBytes: len(hw)})
+	s.NoError(err)
+	s.Equal(6, res.Words)
+}
+
+func (s *ContextSuite) TestCancelled() {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	res, err := AnalyseContext(ctx, strings.NewReader(lorem), Options{})
+	s.True(errors.Is(err, context.Canceled))
+	s.Equal(0, res.Words)
+}
+
+func (s *ContextSuite) TestDeadlineWhileReading() {
+	pr, pw := io.Pipe()
+	defer pw.Close()
+	go pw.Write([]byte(hw))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	// the pipe is never closed, so only the deadline can end the analysis
+	_, err := AnalyseContext(ctx, pr, Options{})
+	var truncated *TruncatedError
+	s.Require().True(errors.As(err, &truncated))
+	s.Equal(TruncatedByContext, truncated.Reason)
+	s.True(errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestAnalyseContext(t *testing.T) {
+	suite.Run(t, new(ContextSuite))
+}
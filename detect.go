@@ -0,0 +1,161 @@
+package textstats
+
+import (
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Detection is the language a text appears to be written in
+type Detection struct {
+	// Code and Name are the ISO 639-1 code and English name of the language,
+	// or empty when the text has no letters to go on, is in a script none of
+	// the profiles use or is as close to two languages as to each other
+	Code string
+	Name string
+
+	// Confidence is how much closer the text is to this language than to
+	// the next closest, from 0 for a tie to 1. Short texts give low
+	// confidence.
+	Confidence float64
+
+	// Language is the detected language, or nil when it isn't one of the
+	// supported Languages or couldn't be detected
+	Language Language
+}
+
+const (
+	// detectLanguageBytes is how much of the start of a text is used to
+	// detect its language
+	detectLanguageBytes = 16 * 1024
+
+	// profileSize is the number of n-grams ranked in a language profile
+	profileSize = 300
+
+	// maxProfileN is the length of the longest n-grams in a profile
+	maxProfileN = 3
+)
+
+// DetectLanguage reads up to the first 16KB of a text and detects the
+// language it is written in by comparing its most common character n-grams
+// with profiles of each known language, using Cavnar and Trenkle's
+// out-of-place measure. The profiles are built into the package, so no
+// network access is needed. Languages that can be detected but aren't
+// supported are reported with a nil Language.
+func DetectLanguage(r io.Reader) (Detection, error) {
+	data, err := ioutil.ReadAll(io.LimitReader(r, detectLanguageBytes))
+	return detectLanguage(data), err
+}
+
+// detectLanguage detects the language of a text
+func detectLanguage(data []byte) Detection {
+	doc := ngramProfile(string(data))
+	if len(doc) == 0 {
+		return Detection{}
+	}
+
+	profiles := languageProfiles()
+
+	type distance struct {
+		code string
+		d    int
+	}
+	distances := make([]distance, 0, len(profiles))
+	var matched bool
+	for code, profile := range profiles {
+		var d int
+		for rank, gram := range doc {
+			if r, ok := profile[gram]; ok {
+				matched = true
+				if r > rank {
+					d += r - rank
+				} else {
+					d += rank - r
+				}
+			} else {
+				d += profileSize
+			}
+		}
+		distances = append(distances, distance{code, d})
+	}
+	sort.Slice(distances, func(i, j int) bool {
+		if distances[i].d == distances[j].d {
+			return distances[i].code < distances[j].code
+		}
+		return distances[i].d < distances[j].d
+	})
+
+	// a text that shares no n-grams with any profile, such as one in another
+	// script, or that is as close to two languages, can't be told apart
+	best, second := distances[0], distances[1]
+	if !matched || best.d == second.d {
+		return Detection{}
+	}
+
+	det := Detection{
+		Code:       best.code,
+		Name:       languageNames[best.code],
+		Confidence: float64(second.d-best.d) / float64(second.d),
+	}
+	det.Language, _ = LanguageByCode(best.code)
+	return det
+}
+
+// ngramProfile returns the most common 1 to 3 character n-grams in the words
+// of a text, most common first. Words are lower cased and padded with an
+// underscore at each end, so n-grams at the start and end of words stand out.
+func ngramProfile(text string) []string {
+	counts := make(map[string]int)
+	for _, word := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	}) {
+		runes := []rune("_" + word + "_")
+		for n := 1; n <= maxProfileN; n++ {
+			for i := 0; i+n <= len(runes); i++ {
+				if gram := string(runes[i : i+n]); gram != "_" {
+					counts[gram]++
+				}
+			}
+		}
+	}
+
+	grams := make([]string, 0, len(counts))
+	for gram := range counts {
+		grams = append(grams, gram)
+	}
+	sort.Slice(grams, func(i, j int) bool {
+		if counts[grams[i]] == counts[grams[j]] {
+			return grams[i] < grams[j]
+		}
+		return counts[grams[i]] > counts[grams[j]]
+	})
+
+	if len(grams) > profileSize {
+		grams = grams[:profileSize]
+	}
+	return grams
+}
+
+var (
+	profilesOnce sync.Once
+	profiles     map[string]map[string]int
+)
+
+// languageProfiles returns the rank of each n-gram in each language's
+// profile, built from languageSamples the first time it is needed
+func languageProfiles() map[string]map[string]int {
+	profilesOnce.Do(func() {
+		profiles = make(map[string]map[string]int, len(languageSamples))
+		for code, sample := range languageSamples {
+			ranks := make(map[string]int, profileSize)
+			for rank, gram := range ngramProfile(sample) {
+				ranks[gram] = rank
+			}
+			profiles[code] = ranks
+		}
+	})
+	return profiles
+}
@@ -0,0 +1,129 @@
+package textstats
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type DetectSuite struct {
+	suite.Suite
+}
+
+func (s *DetectSuite) detect(text string) Detection {
+	det, err := DetectLanguage(strings.NewReader(text))
+	s.NoError(err)
+	return det
+}
+
+func (s *DetectSuite) TestDetectLanguage() {
+	for code, text := range map[string]string{
+		"en": "The quick brown fox jumps over the lazy dog while the farmer watches from his window.",
+		"de": "Der schnelle braune Fuchs springt über den faulen Hund, während der Bauer aus dem Fenster schaut.",
+		"es": "El rápido zorro marrón salta sobre el perro perezoso mientras el granjero mira desde su ventana.",
+		"fr": "Le renard brun rapide saute par-dessus le chien paresseux pendant que le fermier regarde depuis sa fenêtre.",
+		"nl": "De snelle bruine vos springt over de luie hond terwijl de boer vanuit zijn raam toekijkt.",
+		"it": "La volpe marrone veloce salta sopra il cane pigro mentre il contadino guarda dalla sua finestra.",
+		"pt": "A raposa castanha rápida salta por cima do cão preguiçoso enquanto o agricultor olha da sua janela.",
+		"sv": "Den snabba bruna räven hoppar över den lata hunden medan bonden tittar ut genom sitt fönster.",
+	} {
+		det := s.detect(text)
+		s.Equal(code, det.Code, text)
+		s.Greater(det.Confidence, 0.0, text)
+	}
+
+	det := s.detect(lorem)
+	s.NotEmpty(det.Code)
+
+	s.Equal(Detection{}, s.detect("1234 !!"))
+}
+
+func (s *DetectSuite) TestUndetectableScripts() {
+	for _, text := range []string{
+		"Привет, как дела? Всё хорошо.",
+		"ภาษาไทยเป็นภาษาที่สวยงาม",
+	} {
+		s.Equal(Detection{}, s.detect(text), text)
+
+		res, err := AnalyseWithOptions(strings.NewReader(text), Options{DetectLanguage: true})
+		s.NoError(err)
+		s.Equal(English, res.Language())
+		s.Require().NotNil(res.Detection)
+		s.Nil(res.Detection.Language)
+	}
+}
+
+func (s *DetectSuite) TestRelatedLanguages() {
+	for code, text := range map[string]string{
+		"es": "Los niños juegan en la plaza mientras sus padres hablan con los vecinos.",
+		"pt": "As crianças brincam na praça enquanto os pais conversam com os vizinhos.",
+		"it": "I bambini giocano in piazza mentre i genitori parlano con i vicini.",
+		"nl": "De kinderen spelen op het plein terwijl hun ouders met de buren praten.",
+		"de": "Die Kinder spielen auf dem Platz, während ihre Eltern mit den Nachbarn reden.",
+	} {
+		s.Equal(code, s.detect(text).Code, text)
+	}
+}
+
+func (s *DetectSuite) TestDetection() {
+	det := s.detect("Der schnelle braune Fuchs springt über den faulen Hund.")
+	s.Equal("German", det.Name)
+	s.Equal(German, det.Language)
+
+	det = s.detect("La volpe marrone veloce salta sopra il cane pigro.")
+	s.Equal("Italian", det.Name)
+	s.Nil(det.Language)
+}
+
+func (s *DetectSuite) TestAnalyseDetectsLanguage() {
+	text := "Die Vereinigung der Schifffahrtsgesellschaften wurde gegründet."
+	res, err := AnalyseWithOptions(strings.NewReader(text), Options{DetectLanguage: true})
+	s.NoError(err)
+	s.Equal(German, res.Language())
+	s.Equal("de", res.Detection.Code)
+
+	de, _ := AnalyseWithOptions(strings.NewReader(text), Options{Language: German})
+	s.Equal(de.Syllables, res.Syllables)
+
+	// an unsupported language is analysed as English
+	text = "La volpe marrone veloce salta sopra il cane pigro."
+	res, _ = AnalyseWithOptions(strings.NewReader(text), Options{DetectLanguage: true})
+	s.Equal(English, res.Language())
+	s.Equal("it", res.Detection.Code)
+	s.Nil(res.Detection.Language)
+
+	// a language that is given isn't detected
+	res, _ = AnalyseWithOptions(strings.NewReader(text), Options{Language: Spanish, DetectLanguage: true})
+	s.Equal(Spanish, res.Language())
+	s.Nil(res.Detection)
+}
+
+func (s *DetectSuite) TestAnalyserDetectsLanguage() {
+	text := strings.Repeat("Le renard brun rapide saute par-dessus le chien paresseux. ", 400)
+	fr, _ := AnalyseWithOptions(strings.NewReader(text), Options{Language: French})
+
+	a := NewAnalyser(Options{DetectLanguage: true})
+	a.Write([]byte(text[:100]))
+
+	// a snapshot detects the language from what has been written so far
+	snap := a.Results()
+	s.Equal(French, snap.Language())
+	s.Equal(1, snap.Sentences)
+
+	for i := 100; i < len(text); i += 1000 {
+		end := i + 1000
+		if end > len(text) {
+			end = len(text)
+		}
+		a.Write([]byte(text[i:end]))
+	}
+	res := a.finish()
+	s.Equal(French, res.Language())
+	s.Equal(fr.Syllables, res.Syllables)
+	s.Equal(fr.Sentences, res.Sentences)
+}
+
+func TestDetectSuite(t *testing.T) {
+	suite.Run(t, new(DetectSuite))
+}
@@ -0,0 +1,144 @@
+package textstats
+
+import (
+	"math"
+	"sort"
+)
+
+const (
+	// mtldThreshold is the type-token ratio at which MTLD considers a factor
+	// complete, as proposed by McCarthy and Jarvis
+	mtldThreshold = 0.72
+
+	// hddSampleSize is the number of words drawn in the HD-D hypergeometric
+	// sample
+	hddSampleSize = 42
+)
+
+// UniqueWords returns the number of distinct words in the text. It requires
+// the analysis to have been run with Options.WordFrequencies set.
+func (r *Results) UniqueWords() int {
+	return len(r.WordFrequencies)
+}
+
+// TypeTokenRatio returns the ratio of distinct words to total words in the
+// text
+func (r *Results) TypeTokenRatio() float64 {
+	return float64(r.UniqueWords()) / float64(r.Words)
+}
+
+// RootTypeTokenRatio returns Guiraud's root type-token ratio for the text,
+// which is less sensitive to text length than the plain type-token ratio
+func (r *Results) RootTypeTokenRatio() float64 {
+	return float64(r.UniqueWords()) / math.Sqrt(float64(r.Words))
+}
+
+// MTLD returns the measure of textual lexical diversity for the text, the
+// mean of the forward and backward passes. Text too short or too varied to
+// complete a single factor scores its word count.
+func (r *Results) MTLD() float64 {
+	forward := mtldFactors(r.wordSequence, false)
+	backward := mtldFactors(r.wordSequence, true)
+	if forward == 0 || backward == 0 {
+		return float64(len(r.wordSequence))
+	}
+
+	n := float64(len(r.wordSequence))
+	return ((n / forward) + (n / backward)) / 2
+}
+
+// HDD returns the HD-D lexical diversity score for the text, the expected
+// type-token ratio of a random 42 word sample. Texts shorter than the sample
+// size fall back to the plain type-token ratio.
+func (r *Results) HDD() float64 {
+	n := r.Words
+	sample := hddSampleSize
+	if n < sample {
+		sample = n
+	}
+
+	var score float64
+	spectrum := r.frequencySpectrum()
+	for _, freq := range spectrum.frequencies {
+		// probability of the word not appearing at all in the sample
+		var none float64
+		if n-freq >= sample {
+			none = math.Exp(logChoose(n-freq, sample) - logChoose(n, sample))
+		}
+		score += float64(spectrum.types[freq]) * (1 - none) / float64(sample)
+	}
+
+	return score
+}
+
+// YulesK returns Yule's characteristic K for the text. Lower values indicate
+// a richer vocabulary.
+func (r *Results) YulesK() float64 {
+	n := float64(r.Words)
+
+	var sum float64
+	spectrum := r.frequencySpectrum()
+	for _, freq := range spectrum.frequencies {
+		sum += float64(spectrum.types[freq]) * float64(freq) * float64(freq)
+	}
+
+	return 10000 * (sum - n) / (n * n)
+}
+
+// frequencySpectrum describes how many distinct words occur each number of
+// times in a text
+type frequencySpectrum struct {
+	frequencies []int
+	types       map[int]int
+}
+
+// frequencySpectrum groups word frequencies by how often they occur, with the
+// frequencies sorted so calculations over them are deterministic
+func (r *Results) frequencySpectrum() frequencySpectrum {
+	spectrum := frequencySpectrum{types: make(map[int]int)}
+	for _, freq := range r.WordFrequencies {
+		if _, ok := spectrum.types[freq]; !ok {
+			spectrum.frequencies = append(spectrum.frequencies, freq)
+		}
+		spectrum.types[freq]++
+	}
+	sort.Ints(spectrum.frequencies)
+	return spectrum
+}
+
+// mtldFactors counts the MTLD factors in a sequence of words, including the
+// partial factor left over at the end
+func mtldFactors(words []uint32, reverse bool) float64 {
+	var factors float64
+	var count int
+	types := make(map[uint32]struct{})
+	for i := range words {
+		word := words[i]
+		if reverse {
+			word = words[len(words)-1-i]
+		}
+
+		count++
+		types[word] = struct{}{}
+		if float64(len(types))/float64(count) <= mtldThreshold {
+			factors++
+			count = 0
+			types = make(map[uint32]struct{})
+		}
+	}
+
+	if count > 0 {
+		ttr := float64(len(types)) / float64(count)
+		factors += (1 - ttr) / (1 - mtldThreshold)
+	}
+
+	return factors
+}
+
+// logChoose returns the natural log of the binomial coefficient n choose k
+func logChoose(n, k int) float64 {
+	a, _ := math.Lgamma(float64(n + 1))
+	b, _ := math.Lgamma(float64(k + 1))
+	c, _ := math.Lgamma(float64(n - k + 1))
+	return a - b - c
+}
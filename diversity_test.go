@@ -0,0 +1,70 @@
+package textstats
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type DiversitySuite struct {
+	suite.Suite
+}
+
+func (s *DiversitySuite) analyse(text string) *Results {
+	res, err := AnalyseWithOptions(strings.NewReader(text), Options{WordFrequencies: true})
+	s.Require().NoError(err)
+	return res
+}
+
+func (s *DiversitySuite) TestFrequenciesAreOptIn() {
+	res, _ := Analyse(strings.NewReader(qbf))
+	s.Nil(res.WordFrequencies)
+	s.Equal(0, res.UniqueWords())
+}
+
+func (s *DiversitySuite) TestWordFrequencies() {
+	res := s.analyse(qbf)
+	s.Equal(2, res.WordFrequencies["the"])
+	s.Equal(1, res.WordFrequencies["fox"])
+	s.Equal(8, res.UniqueWords())
+}
+
+func (s *DiversitySuite) TestTypeTokenRatio() {
+	s.Equal(0.8888888888888888, s.analyse(qbf).TypeTokenRatio())
+	s.Equal(0.6153846153846154, s.analyse(rep).TypeTokenRatio())
+}
+
+func (s *DiversitySuite) TestRootTypeTokenRatio() {
+	s.Equal(2.6666666666666665, s.analyse(qbf).RootTypeTokenRatio())
+	s.Equal(7.58430874440346, s.analyse(lorem).RootTypeTokenRatio())
+}
+
+func (s *DiversitySuite) TestMTLD() {
+	s.Equal(22.679999999999993, s.analyse(qbf).MTLD())
+	s.Equal(13.0, s.analyse(rep).MTLD())
+	s.Equal(6.0, s.analyse(hw).MTLD())
+}
+
+func (s *DiversitySuite) TestMTLDIgnoresCase() {
+	mixed := s.analyse("The cat saw the Cat. THE CAT saw The cat.")
+	lower := s.analyse("the cat saw the cat. the cat saw the cat.")
+	s.Equal(lower.MTLD(), mixed.MTLD())
+	s.Equal(3, mixed.UniqueWords())
+	s.Less(mixed.MTLD(), float64(mixed.Words))
+}
+
+func (s *DiversitySuite) TestHDD() {
+	s.Equal(0.9405275413215478, s.analyse(lorem).HDD())
+	s.Equal(0.6153846153846154, s.analyse(rep).HDD())
+}
+
+func (s *DiversitySuite) TestYulesK() {
+	s.Equal(246.91358024691357, s.analyse(qbf).YulesK())
+	s.Equal(946.7455621301775, s.analyse(rep).YulesK())
+	s.Equal(0.0, s.analyse(hw).YulesK())
+}
+
+func TestDiversityMethods(t *testing.T) {
+	suite.Run(t, new(DiversitySuite))
+}
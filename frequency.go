@@ -0,0 +1,71 @@
+package textstats
+
+import (
+	"sort"
+	"strings"
+)
+
+// Frequency is a word or phrase along with the number of times it occurs
+type Frequency struct {
+	Text  string
+	Count int
+}
+
+// TopWords returns the n most frequent words in the text, most frequent
+// first, optionally skipping stop words. A non-positive n returns every word.
+// It requires the analysis to have been run with Options.WordFrequencies set.
+func (r *Results) TopWords(n int, excludeStopWords bool) []Frequency {
+	return topFrequencies(r.WordFrequencies, n, excludeStopWords)
+}
+
+// TopBigrams returns the n most frequent two word phrases in the text, most
+// frequent first, optionally skipping phrases made up entirely of stop words.
+// A non-positive n returns every phrase. It requires the analysis to have been
+// run with Options.NGrams set.
+func (r *Results) TopBigrams(n int, excludeStopWords bool) []Frequency {
+	return topFrequencies(r.BigramFrequencies, n, excludeStopWords)
+}
+
+// TopTrigrams returns the n most frequent three word phrases in the text, most
+// frequent first, optionally skipping phrases made up entirely of stop words.
+// A non-positive n returns every phrase. It requires the analysis to have been
+// run with Options.NGrams set.
+func (r *Results) TopTrigrams(n int, excludeStopWords bool) []Frequency {
+	return topFrequencies(r.TrigramFrequencies, n, excludeStopWords)
+}
+
+// topFrequencies sorts a frequency table by descending count, breaking ties
+// alphabetically so the output is stable
+func topFrequencies(table map[string]int, n int, excludeStopWords bool) []Frequency {
+	var freqs []Frequency
+	for text, count := range table {
+		if excludeStopWords && isStopPhrase(text) {
+			continue
+		}
+		freqs = append(freqs, Frequency{Text: text, Count: count})
+	}
+
+	sort.Slice(freqs, func(i, j int) bool {
+		if freqs[i].Count != freqs[j].Count {
+			return freqs[i].Count > freqs[j].Count
+		}
+		return freqs[i].Text < freqs[j].Text
+	})
+
+	if n > 0 && len(freqs) > n {
+		freqs = freqs[:n]
+	}
+
+	return freqs
+}
+
+// isStopPhrase reports whether every word in a space separated phrase is a
+// stop word
+func isStopPhrase(phrase string) bool {
+	for _, word := range strings.Fields(phrase) {
+		if _, ok := StopWords[word]; !ok {
+			return false
+		}
+	}
+	return true
+}
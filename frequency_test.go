@@ -0,0 +1,49 @@
+package textstats
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type FrequencySuite struct {
+	suite.Suite
+	res *Results
+}
+
+func (s *FrequencySuite) SetupTest() {
+	var err error
+	s.res, err = AnalyseWithOptions(strings.NewReader(rep+". "+rep), Options{WordFrequencies: true, NGrams: true})
+	s.Require().NoError(err)
+}
+
+func (s *FrequencySuite) TestNGramsAreOptIn() {
+	res, _ := AnalyseWithOptions(strings.NewReader(rep), Options{WordFrequencies: true})
+	s.Nil(res.BigramFrequencies)
+	s.Empty(res.TopBigrams(0, false))
+}
+
+func (s *FrequencySuite) TestTopWords() {
+	s.Equal([]Frequency{{"the", 8}, {"on", 4}, {"sat", 4}}, s.res.TopWords(3, false))
+	s.Equal([]Frequency{{"sat", 4}, {"cat", 2}, {"dog", 2}}, s.res.TopWords(3, true))
+	s.Len(s.res.TopWords(0, false), 8)
+}
+
+func (s *FrequencySuite) TestTopBigrams() {
+	s.Equal([]Frequency{{"on the", 4}, {"sat on", 4}, {"and the", 2}}, s.res.TopBigrams(3, false))
+	s.Equal([]Frequency{{"sat on", 4}, {"cat sat", 2}, {"dog sat", 2}}, s.res.TopBigrams(3, true))
+}
+
+func (s *FrequencySuite) TestTopTrigrams() {
+	s.Equal([]Frequency{{"sat on the", 4}, {"and the dog", 2}}, s.res.TopTrigrams(2, true))
+}
+
+func (s *FrequencySuite) TestPhrasesDoNotSpanPunctuation() {
+	s.NotContains(s.res.BigramFrequencies, "log the")
+	s.NotContains(s.res.TrigramFrequencies, "the log the")
+}
+
+func TestFrequencyMethods(t *testing.T) {
+	suite.Run(t, new(FrequencySuite))
+}
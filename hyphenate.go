@@ -0,0 +1,274 @@
+package textstats
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"unicode"
+)
+
+// errNoPatterns is returned when a hyphenation pattern file has no patterns
+var errNoPatterns = errors.New("textstats: no hyphenation patterns found")
+
+// Hyphenator finds where words can be hyphenated using Liang's algorithm, as
+// TeX does, from a set of hyphenation patterns. Freely available pattern
+// files cover dozens of languages, and as hyphens mostly fall between
+// syllables they give reasonable syllable counts for all of them.
+type Hyphenator struct {
+	// LeftMin and RightMin are the fewest letters that may be left before
+	// the first hyphen and after the last. They default to 1, which is best
+	// for counting syllables, while TeX typically uses 2 and 3.
+	LeftMin  int
+	RightMin int
+
+	// patterns maps the letters of each pattern to the values between and
+	// around them
+	patterns map[string][]int
+
+	// longest is the number of runes in the longest pattern
+	longest int
+
+	// exceptions maps lower cased words to the rune offsets of their hyphens
+	exceptions map[string][]int
+}
+
+// LoadHyphenator reads TeX hyphenation patterns, such as the hyph-*.tex files
+// from the hyph-utf8 package. Patterns are read from \patterns{...} and
+// exceptions from \hyphenation{...}, with other commands ignored. Plain lists
+// of patterns, such as hyph-*.pat.txt files, are also accepted, in which case
+// any word with hyphens and no digits is an exception. Files must be UTF-8.
+func LoadHyphenator(r io.Reader) (*Hyphenator, error) {
+	h := &Hyphenator{
+		LeftMin:    1,
+		RightMin:   1,
+		patterns:   make(map[string][]int),
+		exceptions: make(map[string][]int),
+	}
+
+	// a file is read as a plain list of patterns until it has a command
+	const (
+		plain = iota
+		outside
+		inPatterns
+		inExceptions
+		inOther
+	)
+	block, command := plain, ""
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '%'); i >= 0 {
+			line = line[:i]
+		}
+		line = strings.NewReplacer("{", " { ", "}", " } ").Replace(line)
+
+		for _, field := range strings.Fields(line) {
+			switch {
+			case field == "{":
+				switch command {
+				case `\patterns`:
+					block = inPatterns
+				case `\hyphenation`:
+					block = inExceptions
+				default:
+					block = inOther
+				}
+				command = ""
+			case field == "}":
+				block = outside
+			case strings.HasPrefix(field, `\`):
+				command = field
+				if block == plain {
+					block = outside
+				}
+			case block == inPatterns:
+				if err := h.addPattern(field); err != nil {
+					return nil, err
+				}
+			case block == inExceptions:
+				h.addException(field)
+			case block == plain:
+				if strings.ContainsRune(field, '-') && strings.IndexFunc(field, unicode.IsDigit) < 0 {
+					h.addException(field)
+				} else if err := h.addPattern(field); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(h.patterns) == 0 && len(h.exceptions) == 0 {
+		return nil, errNoPatterns
+	}
+	return h, nil
+}
+
+// addPattern adds a pattern such as "hen5at", where the digits are the
+// values between the letters, and dots match the start or end of a word
+func (h *Hyphenator) addPattern(pattern string) error {
+	var letters []rune
+	values := []int{0}
+	for _, r := range pattern {
+		if r >= '0' && r <= '9' {
+			values[len(values)-1] = int(r - '0')
+			continue
+		}
+		letters = append(letters, unicode.ToLower(r))
+		values = append(values, 0)
+	}
+	if len(letters) == 0 {
+		return fmt.Errorf("textstats: invalid hyphenation pattern %q", pattern)
+	}
+
+	h.patterns[string(letters)] = values
+	if len(letters) > h.longest {
+		h.longest = len(letters)
+	}
+	return nil
+}
+
+// addException adds a word hyphenated in full, such as "ta-ble"
+func (h *Hyphenator) addException(word string) {
+	var letters []rune
+	var hyphens []int
+	for _, r := range word {
+		if r == '-' {
+			hyphens = append(hyphens, len(letters))
+			continue
+		}
+		letters = append(letters, unicode.ToLower(r))
+	}
+	h.exceptions[string(letters)] = hyphens
+}
+
+// Hyphenate returns the byte offsets in word at which it can be hyphenated.
+// Each part of a word that is already hyphenated is hyphenated separately,
+// and the start of each part after the first is also returned.
+func (h *Hyphenator) Hyphenate(word string) []int {
+	var offsets []int
+	start := 0
+	for i, r := range word + "-" {
+		if r != '-' {
+			continue
+		}
+		if start > 0 && i > start {
+			offsets = append(offsets, start)
+		}
+		offsets = append(offsets, h.hyphenatePart(word[start:i], start)...)
+		start = i + 1
+	}
+	return offsets
+}
+
+// hyphenatePart returns the byte offsets at which a word without hyphens can
+// be hyphenated, adding offset to each
+func (h *Hyphenator) hyphenatePart(part string, offset int) []int {
+	var runes []rune
+	var starts []int
+	for i, r := range part {
+		runes = append(runes, unicode.ToLower(r))
+		starts = append(starts, i)
+	}
+
+	hyphens, ok := h.exceptions[string(runes)]
+	if !ok {
+		hyphens = h.match(runes)
+	}
+
+	var offsets []int
+	for _, i := range hyphens {
+		if i >= h.LeftMin && len(runes)-i >= h.RightMin && i > 0 && i < len(runes) {
+			offsets = append(offsets, offset+starts[i])
+		}
+	}
+	return offsets
+}
+
+// match applies the patterns to a lower cased word, returning the rune
+// offsets at which the highest value is odd
+func (h *Hyphenator) match(runes []rune) []int {
+	word := make([]rune, 0, len(runes)+2)
+	word = append(append(append(word, '.'), runes...), '.')
+
+	values := make([]int, len(word)+1)
+	for i := range word {
+		for j := i + 1; j <= len(word) && j-i <= h.longest; j++ {
+			pattern, ok := h.patterns[string(word[i:j])]
+			if !ok {
+				continue
+			}
+			for k, v := range pattern {
+				if v > values[i+k] {
+					values[i+k] = v
+				}
+			}
+		}
+	}
+
+	// values[i+1] lies before runes[i], as the word starts with a dot
+	var hyphens []int
+	for i := 1; i < len(runes); i++ {
+		if values[i+1]%2 == 1 {
+			hyphens = append(hyphens, i)
+		}
+	}
+	return hyphens
+}
+
+// Syllabify splits a word at the points where it can be hyphenated
+func (h *Hyphenator) Syllabify(word string) []string {
+	var parts []string
+	start := 0
+	for _, offset := range h.Hyphenate(word) {
+		parts = append(parts, word[start:offset])
+		start = offset
+	}
+	return append(parts, word[start:])
+}
+
+// Syllables counts the syllables in a word as the number of its parts with
+// letters when split at the points where it can be hyphenated
+func (h *Hyphenator) Syllables(word string) int {
+	var sCount int
+	for _, part := range h.Syllabify(word) {
+		if strings.IndexFunc(part, unicode.IsLetter) >= 0 {
+			sCount++
+		}
+	}
+	return sCount
+}
+
+// hyphenLanguages numbers the languages made by Hyphenator.Language, to keep
+// their syllable counts apart in a shared SyllableCache
+var hyphenLanguages uint64
+
+// hyphenLanguage is a Language that counts syllables with a Hyphenator
+type hyphenLanguage struct {
+	Language
+	h *Hyphenator
+
+	// key prefixes the words the language's syllable counts are cached under
+	key string
+}
+
+// Language returns a language that counts syllables with the hyphenation
+// patterns rather than by base's rules, but is otherwise the same as base
+func (h *Hyphenator) Language(base Language) Language {
+	n := atomic.AddUint64(&hyphenLanguages, 1)
+	return &hyphenLanguage{
+		Language: base,
+		h:        h,
+		key:      base.Code() + "#" + strconv.FormatUint(n, 10) + " ",
+	}
+}
+
+func (l *hyphenLanguage) Syllables(word string) int { return l.h.Syllables(word) }
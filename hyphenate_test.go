@@ -0,0 +1,93 @@
+package textstats
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// liangPatterns are the patterns that hyphenate "hyphenation" in Liang's
+// thesis, along with a few more for the tests, laid out like a hyph-*.tex file
+const liangPatterns = `% hyph-test.tex
+\message{Test hyphenation patterns}
+\patterns{ % from Liang's thesis
+.hy3ph he2n hena4 hen5at 1na n2at 1tio 2io o2n
+1ta 1ble 1ny 1ed
+}
+\hyphenation{
+ta-ble
+}
+`
+
+type HyphenateSuite struct {
+	suite.Suite
+	h *Hyphenator
+}
+
+func (s *HyphenateSuite) SetupTest() {
+	h, err := LoadHyphenator(strings.NewReader(liangPatterns))
+	s.Require().NoError(err)
+	s.h = h
+}
+
+func (s *HyphenateSuite) TestHyphenate() {
+	s.Equal([]int{2, 6}, s.h.Hyphenate("hyphenation"))
+	s.Equal([]string{"hy", "phen", "ation"}, s.h.Syllabify("hyphenation"))
+	s.Equal([]string{"Hy", "phen", "ation"}, s.h.Syllabify("Hyphenation"))
+	s.Equal([]string{"ta", "ble"}, s.h.Syllabify("table"))
+	s.Equal([]string{"cat"}, s.h.Syllabify("cat"))
+	s.Equal([]string{"hy", "phen-", "ta", "ble"}, s.h.Syllabify("hyphen-table"))
+	s.Equal([]string{""}, s.h.Syllabify(""))
+}
+
+func (s *HyphenateSuite) TestMinimums() {
+	s.h.LeftMin, s.h.RightMin = 2, 6
+	s.Equal([]string{"hy", "phenation"}, s.h.Syllabify("hyphenation"))
+	s.Equal([]string{"table"}, s.h.Syllabify("table"))
+}
+
+func (s *HyphenateSuite) TestSyllables() {
+	s.Equal(3, s.h.Syllables("hyphenation"))
+	s.Equal(2, s.h.Syllables("table"))
+	s.Equal(1, s.h.Syllables("cat"))
+	s.Equal(0, s.h.Syllables("42"))
+	s.Equal(4, s.h.Syllables("hyphen-table"))
+}
+
+func (s *HyphenateSuite) TestPlainPatterns() {
+	h, err := LoadHyphenator(strings.NewReader(".hy3ph he2n hena4 hen5at\n1na n2at 1tio 2io o2n\nta-ble\n"))
+	s.Require().NoError(err)
+	s.Equal([]string{"hy", "phen", "ation"}, h.Syllabify("hyphenation"))
+	s.Equal([]string{"ta", "ble"}, h.Syllabify("table"))
+
+	_, err = LoadHyphenator(strings.NewReader("% nothing here\n\\message{empty}\n"))
+	s.Equal(errNoPatterns, err)
+
+	_, err = LoadHyphenator(strings.NewReader(`\patterns{ 1ta 12 }`))
+	s.EqualError(err, `textstats: invalid hyphenation pattern "12"`)
+}
+
+func (s *HyphenateSuite) TestLanguage() {
+	lang := s.h.Language(English)
+	s.Equal("en", lang.Code())
+	s.Equal(3, lang.Syllables("hyphenation"))
+
+	text := "The hyphenation table."
+	res, err := AnalyseWithOptions(strings.NewReader(text), Options{Language: lang})
+	s.NoError(err)
+	s.Equal(1+3+2, res.Syllables)
+	s.Equal(lang, res.Language())
+	s.Len(lang.Scores(res), 7)
+
+	// the patterns' counts are kept apart from English's in a shared cache
+	cache := NewSyllableCache(10)
+	hyph, _ := AnalyseWithOptions(strings.NewReader("hyphenation"), Options{Language: lang, Cache: cache})
+	en, _ := AnalyseWithOptions(strings.NewReader("hyphenation"), Options{Cache: cache})
+	s.Equal(3, hyph.Syllables)
+	s.Equal(English.Syllables("hyphenation"), en.Syllables)
+}
+
+func TestHyphenateSuite(t *testing.T) {
+	suite.Run(t, new(HyphenateSuite))
+}
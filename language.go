@@ -0,0 +1,345 @@
+package textstats
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Language provides the syllable rules and readability formulas for the
+// language a text is written in. The Dale-Chall word list, passive voice
+// detection and style checks are only available in English. Function words are
+// counted in English, German, Spanish, French and Dutch, as
+// Results.HasFunctionWords reports.
+type Language interface {
+	// Code returns the language's ISO 639-1 code
+	Code() string
+
+	// Name returns the language's English name
+	Name() string
+
+	// Syllables counts the syllables in a word
+	Syllables(word string) int
+
+	// Scores returns the readability scores designed for the language
+	Scores(r *Results) []Score
+}
+
+// Score is a named readability score
+type Score struct {
+	// ID is the score's name in snake case, for use in reports
+	ID    string
+	Name  string
+	Value float64
+}
+
+// language is a Language built from a syllable counting function and a list
+// of formulas
+type language struct {
+	code      string
+	name      string
+	syllables func(word string) int
+	formulas  []formula
+}
+
+// formula is a readability formula as used by a language
+type formula struct {
+	id, name string
+	fn       func(r *Results) float64
+}
+
+func (l *language) Code() string { return l.code }
+
+func (l *language) Name() string { return l.name }
+
+func (l *language) Syllables(word string) int { return l.syllables(word) }
+
+func (l *language) Scores(r *Results) []Score {
+	scores := make([]Score, len(l.formulas))
+	for i, f := range l.formulas {
+		scores[i] = Score{ID: f.id, Name: f.name, Value: f.fn(r)}
+	}
+	return scores
+}
+
+// Languages supported out of the box
+var (
+	English Language = &language{
+		code:      "en",
+		name:      "English",
+		syllables: syllableCount,
+		formulas: []formula{
+			{"flesch_kincaid_reading_ease", "Flesch-Kincaid Reading Ease", (*Results).FleschKincaidReadingEase},
+			{"flesch_kincaid_grade_level", "Flesch-Kincaid Grade Level", (*Results).FleschKincaidGradeLevel},
+			{"gunning_fog_score", "Gunning-Fog Score", (*Results).GunningFogScore},
+			{"coleman_liau_index", "Coleman-Liau Index", (*Results).ColemanLiauIndex},
+			{"smog_index", "SMOG Index", (*Results).SMOGIndex},
+			{"automated_readability_index", "Automated Readability Index", (*Results).AutomatedReadabilityIndex},
+			{"dale_chall_readability_score", "Dale-Chall Readability Score", (*Results).DaleChallReadabilityScore},
+		},
+	}
+
+	German Language = &language{
+		code:      "de",
+		name:      "German",
+		syllables: germanSyllables,
+		formulas: []formula{
+			{"amstad_reading_ease", "Amstad Reading Ease", (*Results).AmstadReadingEase},
+			{"wiener_sachtextformel", "Wiener Sachtextformel", (*Results).WienerSachtextformel},
+		},
+	}
+
+	Spanish Language = &language{
+		code:      "es",
+		name:      "Spanish",
+		syllables: spanishSyllables,
+		formulas: []formula{
+			{"fernandez_huerta_readability", "Fernández Huerta Readability", (*Results).FernandezHuertaReadability},
+			{"szigriszt_pazos_perspicuity", "Szigriszt-Pazos Perspicuity", (*Results).SzigrisztPazosPerspicuity},
+		},
+	}
+
+	French Language = &language{
+		code:      "fr",
+		name:      "French",
+		syllables: frenchSyllables,
+		formulas: []formula{
+			{"kandel_moles_reading_ease", "Kandel-Moles Reading Ease", (*Results).KandelMolesReadingEase},
+		},
+	}
+
+	Dutch Language = &language{
+		code:      "nl",
+		name:      "Dutch",
+		syllables: dutchSyllables,
+		formulas: []formula{
+			{"douma_reading_ease", "Douma Reading Ease", (*Results).DoumaReadingEase},
+		},
+	}
+
+	Chinese Language = &language{
+		code:      "zh",
+		name:      "Chinese",
+		syllables: cjkSyllables,
+		formulas: []formula{
+			{"average_characters_per_sentence", "Average Characters/Sentence", (*Results).AverageCharactersPerSentence},
+			{"uncommon_character_percentage", "Uncommon Characters %", (*Results).UncommonCharacterPercentage},
+		},
+	}
+
+	Japanese Language = &language{
+		code:      "ja",
+		name:      "Japanese",
+		syllables: cjkSyllables,
+		formulas: []formula{
+			{"average_characters_per_sentence", "Average Characters/Sentence", (*Results).AverageCharactersPerSentence},
+			{"kanji_percentage", "Kanji Percentage", (*Results).KanjiPercentage},
+		},
+	}
+)
+
+// functionWordLists are the function words of each language that has a list,
+// by code
+var functionWordLists = map[string]map[string]struct{}{
+	"en": FunctionWords,
+	"de": GermanFunctionWords,
+	"es": SpanishFunctionWords,
+	"fr": FrenchFunctionWords,
+	"nl": DutchFunctionWords,
+}
+
+// Languages lists the supported languages
+var Languages = []Language{English, German, Spanish, French, Dutch, Chinese, Japanese}
+
+// LanguageByCode returns the supported language with the given ISO 639-1
+// code
+func LanguageByCode(code string) (Language, bool) {
+	for _, l := range Languages {
+		if strings.EqualFold(l.Code(), code) {
+			return l, true
+		}
+	}
+	return nil, false
+}
+
+// AmstadReadingEase returns Amstad's adaptation of the Flesch reading ease
+// score for German
+func (r *Results) AmstadReadingEase() float64 {
+	return 180 - r.AverageWordsPerSentence() - (58.5 * r.AverageSyllablesPerWord())
+}
+
+// WienerSachtextformel returns the first Wiener Sachtextformel, the German
+// school grade of a non-fiction text
+func (r *Results) WienerSachtextformel() float64 {
+	var long int
+	for letters, count := range r.WordCountPerLetterCount {
+		if letters > 6 {
+			long += count
+		}
+	}
+
+	polysyllables := r.PercentageWordsWithAtLeastNSyllables(3, true)
+	longWords := float64(long) / float64(r.Words) * 100
+	monosyllables := float64(r.WordCountPerSyllableCount[1]) / float64(r.Words) * 100
+
+	return (0.1935 * polysyllables) + (0.1672 * r.AverageWordsPerSentence()) + (0.1297 * longWords) - (0.0327 * monosyllables) - 0.875
+}
+
+// FernandezHuertaReadability returns Fernández Huerta's adaptation of the
+// Flesch reading ease score for Spanish
+func (r *Results) FernandezHuertaReadability() float64 {
+	syllablesPer100Words := r.AverageSyllablesPerWord() * 100
+	sentencesPer100Words := 100 / r.AverageWordsPerSentence()
+	return 206.84 - (0.60 * syllablesPer100Words) - (1.02 * sentencesPer100Words)
+}
+
+// SzigrisztPazosPerspicuity returns the Szigriszt-Pazos perspicuity score for
+// Spanish
+func (r *Results) SzigrisztPazosPerspicuity() float64 {
+	return 206.835 - (62.3 * r.AverageSyllablesPerWord()) - r.AverageWordsPerSentence()
+}
+
+// KandelMolesReadingEase returns Kandel and Moles' adaptation of the Flesch
+// reading ease score for French
+func (r *Results) KandelMolesReadingEase() float64 {
+	return 207 - (1.015 * r.AverageWordsPerSentence()) - (73.6 * r.AverageSyllablesPerWord())
+}
+
+// DoumaReadingEase returns Douma's adaptation of the Flesch reading ease score
+// for Dutch
+func (r *Results) DoumaReadingEase() float64 {
+	return 206.835 - (0.93 * r.AverageWordsPerSentence()) - (77 * r.AverageSyllablesPerWord())
+}
+
+// nucleusSyllables counts the syllables in a word as the number of vowel
+// nuclei in it. Each run of vowels is split into nuclei by matching the
+// multi-letter nuclei given, longest first, and counting any other vowel as a
+// nucleus of its own. A u after a q is never a nucleus.
+func nucleusSyllables(word, vowels string, nuclei []string) int {
+	runes := []rune(strings.ToLower(word))
+
+	var sCount int
+	for i := 0; i < len(runes); {
+		if !strings.ContainsRune(vowels, runes[i]) || (runes[i] == 'u' && i > 0 && runes[i-1] == 'q') {
+			i++
+			continue
+		}
+
+		size := 1
+		for _, nucleus := range nuclei {
+			if strings.HasPrefix(string(runes[i:]), nucleus) {
+				size = len([]rune(nucleus))
+				break
+			}
+		}
+		sCount++
+		i += size
+	}
+
+	return atLeastOneSyllable(word, sCount)
+}
+
+// atLeastOneSyllable returns sCount, or 1 for a word with letters but no
+// syllables found, such as an abbreviation
+func atLeastOneSyllable(word string, sCount int) int {
+	if sCount == 0 && strings.IndexFunc(word, unicode.IsLetter) >= 0 {
+		return 1
+	}
+	return sCount
+}
+
+const germanVowels = "aeiouyäöü"
+
+// germanNuclei are German diphthongs and long vowels, longest first
+var germanNuclei = []string{"äu", "eu", "ei", "ey", "ai", "ay", "au", "ie", "aa", "ee", "oo"}
+
+func germanSyllables(word string) int {
+	return nucleusSyllables(word, germanVowels, germanNuclei)
+}
+
+const dutchVowels = "aeiouyáéíóúàèëïöü"
+
+// dutchNuclei are Dutch diphthongs, triphthongs and long vowels, longest
+// first. A diaeresis always starts a new syllable, as in "België".
+var dutchNuclei = []string{
+	"aai", "ooi", "oei", "eeu", "ieu",
+	"aa", "ee", "oo", "uu", "ie", "oe", "eu", "ei", "ij", "ui", "ou", "au",
+}
+
+func dutchSyllables(word string) int {
+	return nucleusSyllables(word, dutchVowels, dutchNuclei)
+}
+
+const (
+	frenchVowels    = "aeiouyàâéèêëîïôûùüœæ"
+	frenchDiaeresis = "ëïü"
+)
+
+// frenchSyllables counts each run of vowels in a French word as a syllable,
+// except that a vowel with a diaeresis starts a new one, as in "naïf", and a
+// silent e or es at the end of the word isn't counted
+func frenchSyllables(word string) int {
+	runes := []rune(strings.ToLower(word))
+
+	var sCount int
+	var inRun bool
+	for _, r := range runes {
+		if !strings.ContainsRune(frenchVowels, r) {
+			inRun = false
+			continue
+		}
+		if !inRun || strings.ContainsRune(frenchDiaeresis, r) {
+			sCount++
+		}
+		inRun = true
+	}
+
+	end := len(runes)
+	if end > 1 && runes[end-1] == 's' {
+		end--
+	}
+	if sCount > 1 && end > 1 && runes[end-1] == 'e' && !strings.ContainsRune(frenchVowels, runes[end-2]) {
+		sCount--
+	}
+
+	return atLeastOneSyllable(word, sCount)
+}
+
+const (
+	spanishVowels = "aeiouáéíóúü"
+	spanishStrong = "aeoáéíóú"
+)
+
+// spanishSyllables counts the syllables in a Spanish word. Strong vowels and
+// accented weak vowels each make a syllable, while unaccented weak vowels
+// join the vowels next to them in a diphthong or triphthong. A final y after a
+// vowel, as in "hoy", is a weak vowel.
+func spanishSyllables(word string) int {
+	runes := []rune(strings.ToLower(word))
+	isVowel := func(i int) bool {
+		if runes[i] == 'y' {
+			return len(runes) == 1 || (i == len(runes)-1 && strings.ContainsRune(spanishVowels, runes[i-1]))
+		}
+		return strings.ContainsRune(spanishVowels, runes[i])
+	}
+
+	var sCount int
+	for i := 0; i < len(runes); {
+		if !isVowel(i) {
+			i++
+			continue
+		}
+
+		var strong int
+		for ; i < len(runes) && isVowel(i); i++ {
+			if strings.ContainsRune(spanishStrong, runes[i]) {
+				strong++
+			}
+		}
+		if strong == 0 {
+			strong = 1
+		}
+		sCount += strong
+	}
+
+	return atLeastOneSyllable(word, sCount)
+}
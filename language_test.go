@@ -0,0 +1,93 @@
+package textstats
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type LanguageSuite struct {
+	suite.Suite
+}
+
+func (s *LanguageSuite) syllables(l Language, words string) []int {
+	var counts []int
+	for _, word := range strings.Fields(words) {
+		counts = append(counts, l.Syllables(word))
+	}
+	return counts
+}
+
+func (s *LanguageSuite) TestSyllables() {
+	s.Equal([]int{1, 2, 2, 2, 2, 2, 4}, s.syllables(German, "Haus Feuer Quelle Leute Straße Bäume Vereinigung"))
+	s.Equal([]int{1, 1, 1, 2, 2, 2, 4, 3}, s.syllables(Spanish, "hoy muy y ciudad río leer aéreo españa"))
+	s.Equal([]int{2, 2, 1, 2, 2, 2, 1}, s.syllables(French, "beauté aimée lire naïf Noël oiseau tables"))
+	s.Equal([]int{3, 1, 1, 1, 2, 2, 1}, s.syllables(Dutch, "België huis mooi leeuw meisje koeien nieuw"))
+	s.Equal([]int{1, 1}, s.syllables(German, "BRD Hmm"))
+}
+
+func (s *LanguageSuite) TestScores() {
+	res, _ := AnalyseWithOptions(strings.NewReader("Der schnelle braune Fuchs springt über den faulen Hund. Die Vereinigung der Schifffahrtsgesellschaften wurde gegründet."), Options{Language: German})
+	s.Equal(30, res.Syllables)
+	s.Equal(0, res.DifficultWords)
+	s.Equal([]Score{
+		{ID: "amstad_reading_ease", Name: "Amstad Reading Ease", Value: 55.5},
+		{ID: "wiener_sachtextformel", Name: "Wiener Sachtextformel", Value: 7.046333333333333},
+	}, German.Scores(res))
+
+	res, _ = AnalyseWithOptions(strings.NewReader("El rápido zorro marrón salta sobre el perro perezoso. La ciudad está cerca del río."), Options{Language: Spanish})
+	s.Equal(77.24000000000001, res.FernandezHuertaReadability())
+	s.Equal(78.88833333333335, res.SzigrisztPazosPerspicuity())
+
+	res, _ = AnalyseWithOptions(strings.NewReader("Le renard brun rapide saute par-dessus le chien paresseux. Les oiseaux chantent."), Options{Language: French})
+	s.Equal(78.24333333333334, res.KandelMolesReadingEase())
+
+	res, _ = AnalyseWithOptions(strings.NewReader("De snelle bruine vos springt over de luie hond. Het meisje woont in België."), Options{Language: Dutch})
+	s.Equal(84.82500000000002, res.DoumaReadingEase())
+
+	// English remains the default
+	res, _ = Analyse(strings.NewReader(lorem))
+	s.Len(English.Scores(res), 7)
+	s.Equal(res.FleschKincaidGradeLevel(), English.Scores(res)[1].Value)
+}
+
+func (s *LanguageSuite) TestFunctionWords() {
+	res, _ := AnalyseWithOptions(strings.NewReader("Der Hund und die Katze sind in dem Haus."), Options{Language: German})
+	s.True(res.HasFunctionWords())
+	s.Equal(6, res.FunctionWords)
+	s.Equal(3, res.ContentWords)
+	s.InDelta(33.3, res.LexicalDensity(), 0.1)
+
+	res, _ = AnalyseWithOptions(strings.NewReader("Le chien et le chat sont dans la maison."), Options{Language: French})
+	s.Equal(6, res.FunctionWords)
+
+	res, _ = AnalyseWithOptions(strings.NewReader("我们学中文。"), Options{Language: Chinese})
+	s.False(res.HasFunctionWords())
+	s.Equal(0, res.FunctionWords+res.ContentWords)
+	s.True(math.IsNaN(res.LexicalDensity()))
+}
+
+func (s *LanguageSuite) TestSharedCacheKeepsLanguagesApart() {
+	cache := NewSyllableCache(100)
+	de, _ := AnalyseWithOptions(strings.NewReader("Leute"), Options{Language: German, Cache: cache})
+	en, _ := AnalyseWithOptions(strings.NewReader("Leute"), Options{Cache: cache})
+	s.Equal(2, de.Syllables)
+	s.Equal(English.Syllables("Leute"), en.Syllables)
+	s.Equal(uint64(3), cache.Stats().Misses)
+}
+
+func (s *LanguageSuite) TestLanguageByCode() {
+	l, ok := LanguageByCode("DE")
+	s.True(ok)
+	s.Equal(German, l)
+	s.Equal("German", l.Name())
+
+	_, ok = LanguageByCode("xx")
+	s.False(ok)
+}
+
+func TestLanguages(t *testing.T) {
+	suite.Run(t, new(LanguageSuite))
+}
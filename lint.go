@@ -0,0 +1,275 @@
+package textstats
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// FindingKind identifies the style rule that produced a Finding
+type FindingKind string
+
+// Kinds of style findings reported by a Linter
+const (
+	AdverbFinding      FindingKind = "adverb"
+	WeaselWordFinding  FindingKind = "weasel-word"
+	HedgeFinding       FindingKind = "hedge"
+	ClicheFinding      FindingKind = "cliche"
+	WordyPhraseFinding FindingKind = "wordy-phrase"
+
+	PlainLanguageFinding FindingKind = "plain-language"
+	HardSentenceFinding  FindingKind = "hard-sentence"
+)
+
+// Finding is a style problem found in the text
+type Finding struct {
+	Kind FindingKind
+
+	// Sentence is the zero based index of the sentence containing the
+	// finding
+	Sentence int
+
+	// Start and End are the byte offsets of the finding in the text
+	Start int
+	End   int
+
+	// Text is the word or phrase that was flagged, with its words separated
+	// by single spaces, so it differs from the text between Start and End
+	// when a phrase is wrapped across lines. It is empty for findings about a
+	// whole sentence.
+	Text string
+
+	// Message describes the problem and Suggestion, when not empty, is a
+	// replacement for Text
+	Message    string
+	Suggestion string
+}
+
+// Linter flags -ly adverbs, weasel words, hedges, cliches, wordy phrases and
+// complex words with plain language alternatives. Its lists start as copies of
+// the package defaults and may be extended or trimmed before linting.
+type Linter struct {
+	Adverbs       bool
+	WeaselWords   map[string]struct{}
+	Hedges        map[string]struct{}
+	Cliches       map[string]struct{}
+	WordyPhrases  map[string]string
+	PlainLanguage map[string]string
+
+	// MaxSentenceGrade flags sentences whose Flesch-Kincaid grade level is
+	// above it, when greater than zero
+	MaxSentenceGrade float64
+}
+
+// NewLinter returns a Linter using the default word and phrase lists
+func NewLinter() *Linter {
+	l := &Linter{
+		Adverbs:       true,
+		WeaselWords:   make(map[string]struct{}),
+		Hedges:        make(map[string]struct{}),
+		Cliches:       make(map[string]struct{}),
+		WordyPhrases:  make(map[string]string),
+		PlainLanguage: make(map[string]string),
+	}
+	for word := range WeaselWords {
+		l.WeaselWords[word] = struct{}{}
+	}
+	for phrase := range Hedges {
+		l.Hedges[phrase] = struct{}{}
+	}
+	for phrase := range Cliches {
+		l.Cliches[phrase] = struct{}{}
+	}
+	for phrase, suggestion := range WordyPhrases {
+		l.WordyPhrases[phrase] = suggestion
+	}
+	for phrase, suggestion := range PlainLanguage {
+		l.PlainLanguage[phrase] = suggestion
+	}
+	return l
+}
+
+// Lint scans a reader and returns the style findings in the order they occur
+func (l *Linter) Lint(r io.Reader) ([]Finding, error) {
+	res, err := AnalyseWithOptions(r, Options{Linter: l})
+	sort.SliceStable(res.Findings, func(i, j int) bool {
+		return res.Findings[i].Start < res.Findings[j].Start
+	})
+	return res.Findings, err
+}
+
+// Lint scans a reader with the default Linter and returns the style findings
+// in the order they occur
+func Lint(r io.Reader) ([]Finding, error) {
+	return NewLinter().Lint(r)
+}
+
+// lintRule is a word or phrase to flag and how to report it
+type lintRule struct {
+	kind       FindingKind
+	message    string
+	suggestion string
+}
+
+// lintWord is a word seen by the lint state along with its location
+type lintWord struct {
+	text       string
+	lower      string
+	start, end int
+}
+
+// lintState matches the Linter's phrases against the words of the text as
+// they are analysed
+type lintState struct {
+	linter   *Linter
+	rules    map[string]lintRule
+	maxWords int
+	window   []lintWord
+
+	// key is reused to build the phrases looked up in rules
+	key []byte
+}
+
+// nonAdverbs are common words ending in -ly that aren't adverbs
+var nonAdverbs = map[string]struct{}{
+	"apply":    struct{}{},
+	"belly":    struct{}{},
+	"bully":    struct{}{},
+	"daily":    struct{}{},
+	"early":    struct{}{},
+	"family":   struct{}{},
+	"friendly": struct{}{},
+	"holy":     struct{}{},
+	"italy":    struct{}{},
+	"jelly":    struct{}{},
+	"july":     struct{}{},
+	"likely":   struct{}{},
+	"lonely":   struct{}{},
+	"lovely":   struct{}{},
+	"monthly":  struct{}{},
+	"only":     struct{}{},
+	"reply":    struct{}{},
+	"silly":    struct{}{},
+	"supply":   struct{}{},
+	"ugly":     struct{}{},
+	"weekly":   struct{}{},
+	"yearly":   struct{}{},
+}
+
+// newLintState indexes the Linter's lists by lower cased phrase
+func newLintState(l *Linter) *lintState {
+	state := &lintState{linter: l, rules: make(map[string]lintRule)}
+	add := func(phrase string, rule lintRule) {
+		phrase = strings.ToLower(phrase)
+		state.rules[phrase] = rule
+		if n := len(strings.Fields(phrase)); n > state.maxWords {
+			state.maxWords = n
+		}
+	}
+
+	for word := range l.WeaselWords {
+		add(word, lintRule{kind: WeaselWordFinding, message: "weasel word"})
+	}
+	for phrase := range l.Hedges {
+		add(phrase, lintRule{kind: HedgeFinding, message: "hedge"})
+	}
+	for phrase := range l.Cliches {
+		add(phrase, lintRule{kind: ClicheFinding, message: "cliche"})
+	}
+	for phrase, suggestion := range l.WordyPhrases {
+		add(phrase, lintRule{kind: WordyPhraseFinding, message: "wordy phrase", suggestion: suggestion})
+	}
+	for phrase, suggestion := range l.PlainLanguage {
+		add(phrase, lintRule{kind: PlainLanguageFinding, message: "complex wording", suggestion: suggestion})
+	}
+
+	return state
+}
+
+// analyseLint checks the words and phrases ending at the given word against
+// the lint rules
+func analyseLint(word, lower string, start, end int, res *Results) {
+	state := res.lint
+	if state.maxWords > 0 {
+		if len(state.window) == state.maxWords {
+			state.window = append(state.window[:0], state.window[1:]...)
+		}
+		state.window = append(state.window, lintWord{text: word, lower: lower, start: start, end: end})
+	}
+
+	if state.linter.Adverbs && len(lower) > 4 && strings.HasSuffix(lower, "ly") {
+		if _, ok := nonAdverbs[lower]; !ok {
+			res.Findings = append(res.Findings, Finding{
+				Kind:     AdverbFinding,
+				Sentence: res.sentence.index,
+				Start:    start,
+				End:      end,
+				Text:     word,
+				Message:  "adverb",
+			})
+		}
+	}
+
+	// check the longest phrases first so findings for the same end position
+	// are ordered by where they start
+	for n := len(state.window); n > 0; n-- {
+		words := state.window[len(state.window)-n:]
+
+		state.key = state.key[:0]
+		for i, w := range words {
+			if i > 0 {
+				state.key = append(state.key, ' ')
+			}
+			state.key = append(state.key, w.lower...)
+		}
+
+		rule, ok := state.rules[string(state.key)]
+		if !ok {
+			continue
+		}
+
+		texts := make([]string, n)
+		for i, w := range words {
+			texts[i] = w.text
+		}
+
+		res.Findings = append(res.Findings, Finding{
+			Kind:       rule.kind,
+			Sentence:   res.sentence.index,
+			Start:      words[0].start,
+			End:        end,
+			Text:       strings.Join(texts, " "),
+			Message:    rule.message,
+			Suggestion: rule.suggestion,
+		})
+	}
+}
+
+// lintSentence flags the sentence ending at the given offset if it is harder
+// to read than the Linter allows
+func lintSentence(end int, res *Results) {
+	limit := res.lint.linter.MaxSentenceGrade
+	state := &res.sentence
+	if limit <= 0 || state.words == 0 {
+		return
+	}
+
+	grade := sentenceTotals{words: state.words, sentences: 1, syllables: state.syllables}.grade()
+	if grade <= limit {
+		return
+	}
+
+	res.Findings = append(res.Findings, Finding{
+		Kind:     HardSentenceFinding,
+		Sentence: state.index,
+		Start:    state.start,
+		End:      end,
+		Message:  fmt.Sprintf("sentence grade level %.1f is above %g", grade, limit),
+	})
+}
+
+// endLintPhrase stops phrases from matching across punctuation
+func endLintPhrase(res *Results) {
+	res.lint.window = res.lint.window[:0]
+}
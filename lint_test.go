@@ -0,0 +1,105 @@
+package textstats
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type LintSuite struct {
+	suite.Suite
+}
+
+func (s *LintSuite) TestLint() {
+	findings, err := Lint(strings.NewReader(lint))
+	s.NoError(err)
+
+	var kinds []FindingKind
+	for _, f := range findings {
+		kinds = append(kinds, f.Kind)
+		s.Equal(f.Text, lint[f.Start:f.End])
+	}
+	s.Equal([]FindingKind{
+		HedgeFinding,
+		AdverbFinding,
+		WeaselWordFinding,
+		AdverbFinding,
+		WordyPhraseFinding,
+		ClicheFinding,
+		HedgeFinding,
+		ClicheFinding,
+		WeaselWordFinding,
+	}, kinds)
+}
+
+func (s *LintSuite) TestWordyPhraseSuggestion() {
+	findings, _ := Lint(strings.NewReader("We met in order to talk."))
+	s.Equal([]Finding{{
+		Kind:       WordyPhraseFinding,
+		Sentence:   0,
+		Start:      7,
+		End:        18,
+		Text:       "in order to",
+		Message:    "wordy phrase",
+		Suggestion: "to",
+	}}, findings)
+}
+
+func (s *LintSuite) TestPhrasesDoNotSpanPunctuation() {
+	findings, _ := Lint(strings.NewReader("I know what I think. It seems fine"))
+	s.Len(findings, 2)
+
+	findings, _ = Lint(strings.NewReader("Why do I, think about this"))
+	s.Empty(findings)
+}
+
+func (s *LintSuite) TestPhraseAcrossLines() {
+	text := "We met in order\nto talk."
+	findings, _ := Lint(strings.NewReader(text))
+	s.Len(findings, 1)
+	s.Equal("in order to", findings[0].Text)
+	s.Equal("in order\nto", text[findings[0].Start:findings[0].End])
+}
+
+func (s *LintSuite) TestUserLists() {
+	l := NewLinter()
+	l.Adverbs = false
+	delete(l.WeaselWords, "very")
+	l.Cliches["bright and early"] = struct{}{}
+	l.WordyPhrases["make a decision"] = "decide"
+
+	findings, _ := l.Lint(strings.NewReader("We came bright and early to make a decision, very quickly."))
+	s.Len(findings, 2)
+	s.Equal(ClicheFinding, findings[0].Kind)
+	s.Equal("decide", findings[1].Suggestion)
+
+	// the package defaults are untouched
+	s.Contains(WeaselWords, "very")
+}
+
+func (s *LintSuite) TestMaxSentenceGrade() {
+	text := "The cat sat. The considerably overweight canine perambulated unexpectedly."
+	l := NewLinter()
+	l.Adverbs = false
+	l.MaxSentenceGrade = 8
+
+	findings, err := l.Lint(strings.NewReader(text))
+	s.NoError(err)
+	s.Len(findings, 1)
+
+	f := findings[0]
+	s.Equal(HardSentenceFinding, f.Kind)
+	s.Equal(1, f.Sentence)
+	s.Equal("The considerably overweight canine perambulated unexpectedly.", text[f.Start:f.End])
+	s.Equal("", f.Text)
+	s.Contains(f.Message, "is above 8")
+
+	l.MaxSentenceGrade = 0
+	findings, _ = l.Lint(strings.NewReader(text))
+	s.Empty(findings)
+}
+
+func TestLintMethods(t *testing.T) {
+	suite.Run(t, new(LintSuite))
+}
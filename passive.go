@@ -0,0 +1,118 @@
+package textstats
+
+import "strings"
+
+// Passive is a likely passive voice construction, a form of "to be" followed
+// by a past participle, found in the text
+type Passive struct {
+	// Sentence is the zero based index of the sentence containing the
+	// construction
+	Sentence int
+
+	// Start and End are the byte offsets of the construction in the text
+	Start int
+	End   int
+
+	// Text is the construction itself, e.g. "was quickly eaten", with its
+	// words separated by single spaces. It differs from the text between Start
+	// and End when the construction is wrapped across lines or has
+	// punctuation in it.
+	Text string
+}
+
+// passiveState tracks a form of "to be" that may begin a passive construction
+// in the current sentence
+type passiveState struct {
+	text     string
+	start    int
+	pending  bool
+	sentence bool
+}
+
+var beForms = map[string]struct{}{
+	"am":    struct{}{},
+	"are":   struct{}{},
+	"be":    struct{}{},
+	"been":  struct{}{},
+	"being": struct{}{},
+	"is":    struct{}{},
+	"was":   struct{}{},
+	"were":  struct{}{},
+}
+
+// adverbs that commonly sit between "to be" and a participle, beyond those
+// ending in -ly
+var passiveAdverbs = map[string]struct{}{
+	"also":   struct{}{},
+	"always": struct{}{},
+	"never":  struct{}{},
+	"not":    struct{}{},
+	"often":  struct{}{},
+	"still":  struct{}{},
+}
+
+// words ending in -ed that are not past participles
+var nonParticiples = map[string]struct{}{
+	"bed":    struct{}{},
+	"feed":   struct{}{},
+	"indeed": struct{}{},
+	"naked":  struct{}{},
+	"need":   struct{}{},
+	"red":    struct{}{},
+	"sacred": struct{}{},
+	"seed":   struct{}{},
+	"speed":  struct{}{},
+	"wicked": struct{}{},
+}
+
+// isPastParticiple reports whether a lower cased word looks like a past
+// participle
+func isPastParticiple(word string) bool {
+	if _, ok := IrregularParticiples[word]; ok {
+		return true
+	}
+	if _, ok := nonParticiples[word]; ok {
+		return false
+	}
+	return len(word) > 3 && strings.HasSuffix(word, "ed")
+}
+
+// analysePassive looks for a passive construction ending at the given word,
+// which spans the byte offsets start to end
+func analysePassive(word, lower string, start, end int, res *Results) {
+	state := &res.passive
+
+	if state.pending && isPastParticiple(lower) {
+		res.Passives = append(res.Passives, Passive{
+			Sentence: res.sentence.index,
+			Start:    state.start,
+			End:      end,
+			Text:     state.text + " " + word,
+		})
+		if !state.sentence {
+			res.PassiveSentences++
+			state.sentence = true
+		}
+		state.pending = false
+		return
+	}
+
+	if _, ok := beForms[lower]; ok {
+		state.text = word
+		state.start = start
+		state.pending = true
+		return
+	}
+
+	if _, ok := passiveAdverbs[lower]; state.pending && (ok || strings.HasSuffix(lower, "ly")) {
+		state.text += " " + word
+		return
+	}
+
+	state.pending = false
+}
+
+// endPassiveSentence resets passive detection at the end of a sentence
+func endPassiveSentence(res *Results) {
+	res.passive = passiveState{}
+}
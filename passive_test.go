@@ -0,0 +1,61 @@
+package textstats
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type PassiveSuite struct {
+	suite.Suite
+}
+
+func (s *PassiveSuite) TestPassiveSentences() {
+	res, _ := Analyse(strings.NewReader(pasv))
+	s.Equal(3, res.PassiveSentences)
+
+	res, _ = Analyse(strings.NewReader(qbf))
+	s.Equal(0, res.PassiveSentences)
+	s.Empty(res.Passives)
+}
+
+func (s *PassiveSuite) TestPassiveLocations() {
+	res, _ := Analyse(strings.NewReader(pasv))
+	s.Equal([]Passive{
+		{Sentence: 0, Start: 9, End: 18, Text: "was eaten"},
+		{Sentence: 2, Start: 54, End: 70, Text: "were not written"},
+		{Sentence: 2, Start: 89, End: 109, Text: "were quickly written"},
+		{Sentence: 3, Start: 126, End: 140, Text: "being followed"},
+	}, res.Passives)
+
+	for _, p := range res.Passives {
+		s.Equal(p.Text, pasv[p.Start:p.End])
+	}
+}
+
+func (s *PassiveSuite) TestPassiveAcrossLines() {
+	text := "The ball was\nkicked by John."
+	res, _ := Analyse(strings.NewReader(text))
+	s.Equal([]Passive{{Sentence: 0, Start: 9, End: 19, Text: "was kicked"}}, res.Passives)
+	s.Equal("was\nkicked", text[9:19])
+}
+
+func (s *PassiveSuite) TestPassivesAreEnglishOnly() {
+	res, _ := AnalyseWithOptions(strings.NewReader("Der Kuchen was eaten. Die Tür is closed."), Options{Language: German})
+	s.Equal(0, res.PassiveSentences)
+	s.Empty(res.Passives)
+}
+
+func (s *PassiveSuite) TestIsPastParticiple() {
+	s.True(isPastParticiple("walked"))
+	s.True(isPastParticiple("written"))
+	s.True(isPastParticiple("led"))
+	s.False(isPastParticiple("red"))
+	s.False(isPastParticiple("indeed"))
+	s.False(isPastParticiple("walk"))
+}
+
+func TestPassiveMethods(t *testing.T) {
+	suite.Run(t, new(PassiveSuite))
+}
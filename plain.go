@@ -0,0 +1,97 @@
+package textstats
+
+import (
+	"io"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Substitution is a plain language replacement for a complex word or phrase,
+// along with its estimated effect on the readability of the text
+type Substitution struct {
+	Finding
+
+	// GradeLevelChange is the estimated change in the text's
+	// FleschKincaidGradeLevel if only this substitution were applied
+	GradeLevelChange float64
+
+	// DifficultWordChange is the change in the number of words missing from
+	// the Dale-Chall familiar word list if only this substitution were
+	// applied
+	DifficultWordChange int
+}
+
+// Simplify scans a reader and returns a plain language substitution for each
+// complex word or phrase from PlainLanguage found in it, in the order they
+// occur
+func Simplify(r io.Reader) ([]Substitution, error) {
+	res, err := AnalyseWithOptions(r, Options{Linter: &Linter{PlainLanguage: PlainLanguage}})
+
+	subs := make([]Substitution, 0, len(res.Findings))
+	for _, f := range res.Findings {
+		before, _ := Analyse(strings.NewReader(f.Text))
+		after, _ := Analyse(strings.NewReader(f.Suggestion))
+		subs = append(subs, Substitution{
+			Finding:             f,
+			GradeLevelChange:    res.GradeLevelChange(f.Text, f.Suggestion),
+			DifficultWordChange: after.DifficultWords - before.DifficultWords,
+		})
+	}
+
+	sort.SliceStable(subs, func(i, j int) bool {
+		return subs[i].Start < subs[j].Start
+	})
+
+	return subs, err
+}
+
+// GradeLevelChange estimates how FleschKincaidGradeLevel would change if one
+// occurrence of original in the text were replaced with replacement
+func (r *Results) GradeLevelChange(original, replacement string) float64 {
+	before, _ := Analyse(strings.NewReader(original))
+	after, _ := Analyse(strings.NewReader(replacement))
+
+	adjusted := Results{
+		Words:     r.Words + after.Words - before.Words,
+		Sentences: r.Sentences,
+		Syllables: r.Syllables + after.Syllables - before.Syllables,
+	}
+
+	return adjusted.FleschKincaidGradeLevel() - r.FleschKincaidGradeLevel()
+}
+
+// Rewrite applies substitutions to the text they were found in, keeping the
+// capitalisation of the words being replaced. Substitutions overlapping an
+// earlier one are skipped.
+func Rewrite(text string, subs []Substitution) string {
+	var out strings.Builder
+	var last int
+	for _, sub := range subs {
+		if sub.Start < last || sub.End > len(text) {
+			continue
+		}
+		out.WriteString(text[last:sub.Start])
+		out.WriteString(matchCase(text[sub.Start:sub.End], sub.Suggestion))
+		last = sub.End
+	}
+	out.WriteString(text[last:])
+	return out.String()
+}
+
+// matchCase capitalises replacement to match original, which is either all
+// upper case or starts with an upper case letter
+func matchCase(original, replacement string) string {
+	if strings.ToUpper(original) == original && strings.ToLower(original) != original {
+		return strings.ToUpper(replacement)
+	}
+
+	first, _ := utf8.DecodeRuneInString(original)
+	if !unicode.IsUpper(first) {
+		return replacement
+	}
+
+	r, size := utf8.DecodeRuneInString(replacement)
+	return string(unicode.ToUpper(r)) + replacement[size:]
+}
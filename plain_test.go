@@ -0,0 +1,50 @@
+package textstats
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type PlainSuite struct {
+	suite.Suite
+}
+
+func (s *PlainSuite) TestSimplify() {
+	subs, err := Simplify(strings.NewReader(plain))
+	s.NoError(err)
+
+	var replacements []string
+	for _, sub := range subs {
+		s.Equal(PlainLanguageFinding, sub.Kind)
+		s.Equal(sub.Text, plain[sub.Start:sub.End])
+		s.True(sub.GradeLevelChange < 0, "%q should lower the grade level", sub.Text)
+		s.Equal(-1, sub.DifficultWordChange)
+		replacements = append(replacements, sub.Text+"="+sub.Suggestion)
+	}
+	s.Equal([]string{
+		"Utilize=use",
+		"remainder=rest",
+		"commence=start",
+		"in accordance with=under",
+		"TERMINATE=end",
+	}, replacements)
+}
+
+func (s *PlainSuite) TestGradeLevelChange() {
+	res, _ := Analyse(strings.NewReader(plain))
+	s.Equal(-1.3882352941176457, res.GradeLevelChange("utilize", "use"))
+	s.Equal(-0.2511764705882342, res.GradeLevelChange("in accordance with", "under"))
+	s.Equal(0.0, res.GradeLevelChange("use", "use"))
+}
+
+func (s *PlainSuite) TestRewrite() {
+	subs, _ := Simplify(strings.NewReader(plain))
+	s.Equal("Use the rest of the funds. We will start under the plan, and END later.", Rewrite(plain, subs))
+	s.Equal(plain, Rewrite(plain, nil))
+}
+
+func TestPlainMethods(t *testing.T) {
+	suite.Run(t, new(PlainSuite))
+}
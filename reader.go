@@ -1,7 +1,7 @@
 package textstats
 
 import (
-	"bufio"
+	"context"
 	"io"
 	"math"
 	"strings"
@@ -18,11 +18,226 @@ type Results struct {
 	Spaces         int
 	Syllables      int
 	DifficultWords int
+	FunctionWords  int
+	ContentWords   int
+
+	// HanCharacters and KanaCharacters are the number of Chinese characters
+	// or Japanese kanji, and of Japanese kana, each of which is also counted
+	// as a word. UncommonHanCharacters is the number of Han characters that
+	// aren't among the CommonHanCharacters.
+	HanCharacters         int
+	KanaCharacters        int
+	UncommonHanCharacters int
+
+	// UnsupportedScripts maps the names of scripts written without spaces
+	// between words, such as Thai, to the number of letters in them. Their
+	// words can't be told apart, so counts for text in these scripts are
+	// wrong.
+	UnsupportedScripts map[string]int
+
+	// WordCountPerSyllableCount maps the number of syllables in a word to
+	// the number of words with that many syllables
+	WordCountPerSyllableCount map[int]int
+
+	// WordCountPerSyllableCountByClass breaks WordCountPerSyllableCount down
+	// by the exact set of classes each word belongs to
+	WordCountPerSyllableCountByClass map[WordClass]map[int]int
+
+	// WordCountPerLetterCount maps the number of letters in a word to the
+	// number of words of that length
+	WordCountPerLetterCount map[int]int
+
+	// SentenceCountPerWordCount maps the number of words in a sentence to
+	// the number of sentences of that length. Text after the last sentence
+	// terminator counts as a sentence, while terminators without any words
+	// before them, such as the extra dots in an ellipsis, do not.
+	SentenceCountPerWordCount map[int]int
+
+	// LongSentences lists the sentences with more words than
+	// Options.LongSentenceWords
+	LongSentences []LongSentence
+
+	// WordFrequencies maps each lower cased word to the number of times it
+	// occurs. It is only populated when Options.WordFrequencies is set.
+	WordFrequencies map[string]int
+
+	// BigramFrequencies and TrigramFrequencies map each lower cased two and
+	// three word phrase to the number of times it occurs. Phrases never span
+	// punctuation. They are only populated when Options.NGrams is set.
+	BigramFrequencies  map[string]int
+	TrigramFrequencies map[string]int
+
+	// PassiveSentences is the number of sentences containing at least one
+	// likely passive voice construction, each of which is listed in Passives
+	PassiveSentences int
+	Passives         []Passive
+
+	// Findings lists style problems in the order they occur. It is only
+	// populated when Options.Linter is set.
+	Findings []Finding
+
+	// Detection is the language detected from the start of the text. It is
+	// only set when Options.DetectLanguage is.
+	Detection *Detection
+
+	// wordSequence holds the ID of every lower cased word, from wordIDs, in
+	// the order it was seen, for measures such as MTLD that depend on word
+	// order
+	wordSequence []uint32
+	wordIDs      map[string]uint32
+
+	// phrase holds the most recent words since the last punctuation, from
+	// which bigrams and trigrams are built
+	phrase []string
+
+	// properNouns holds the capitalised words seen in the middle of a
+	// sentence
+	properNouns map[string]struct{}
+
+	// sentence tracks the length of the current sentence
+	sentence sentenceState
+
+	// passive tracks a possible passive construction in the current sentence
+	passive passiveState
+
+	// lint matches Options.Linter's rules against the words of the text
+	lint *lintState
+
+	// memo caches the syllable counts and difficulty of words
+	memo wordLookup
+
+	// opts are the options the analysis was run with
+	opts Options
+}
+
+// Options controls optional parts of an analysis that cost extra memory or
+// time
+type Options struct {
+	// WordFrequencies enables tracking of how often each distinct word
+	// occurs, which is required for the lexical diversity measures. Memory use
+	// grows with the number of distinct words, plus four bytes for every word
+	// in the text as MTLD needs to know the order they came in.
+	WordFrequencies bool
+
+	// NGrams enables tracking of how often each two and three word phrase
+	// occurs. Memory use grows with the size of the text.
+	NGrams bool
+
+	// LongSentenceWords, when positive, is the number of words a sentence
+	// may have before it is listed in Results.LongSentences
+	LongSentenceWords int
+
+	// Linter, when set, checks every word and phrase against its style rules
+	// and records the results in Results.Findings
+	Linter *Linter
+
+	// Numbers controls whether numbers count as words and how many
+	// syllables they have. By default they are ignored.
+	Numbers NumberPolicy
+
+	// Acronyms controls how syllables are counted for acronyms
+	Acronyms AcronymPolicy
+
+	// SplitCompounds splits hyphenated words and words with apostrophes, such
+	// as "well-known" and "don't", into separate words rather than keeping
+	// them as one
+	SplitCompounds bool
+
+	// Normalisation controls how words are normalised. Invisible formatting
+	// characters, such as soft hyphens and zero width joiners, are always
+	// ignored, and combining marks always belong to the word they follow.
+	Normalisation NormalisationPolicy
+
+	// MaxBytes and MaxWords, when positive, limit how much of the text is
+	// analysed. An analysis that reaches either limit stops early and
+	// returns a TruncatedError.
+	MaxBytes int
+	MaxWords int
+
+	// Language is the language of the text, which decides how syllables are
+	// counted. It defaults to English.
+	Language Language
+
+	// DetectLanguage, when Language isn't set, detects the language from the
+	// start of the text and analyses it by that language's rules. Texts in
+	// languages that aren't supported are analysed as English, and
+	// Results.Detection shows which language was found either way.
+	// Detection is done by AnalyseWithOptions, AnalyseContext and Analyser.
+	DetectLanguage bool
+
+	// Cache, when set, is used to look up the syllable counts and difficulty
+	// of words instead of a cache private to the analysis, so they are only
+	// worked out once across many analyses
+	Cache *SyllableCache
+}
+
+// isEnglish reports whether a language is English, including English with
+// syllables counted some other way
+func isEnglish(lang Language) bool {
+	return lang.Code() == English.Code()
+}
+
+// Language returns the language the text was analysed as
+func (r *Results) Language() Language {
+	return r.opts.language()
+}
 
-	WordCountPerSyllableCountIncludingProperNouns map[int]int
-	WordCountPerSyllableCountExcludingProperNouns map[int]int
+// language returns the language of the text
+func (o *Options) language() Language {
+	if o.Language == nil {
+		return English
+	}
+	return o.Language
 }
 
+// NumberPolicy controls how numbers in the text are counted
+type NumberPolicy int
+
+// Policies for counting numbers
+const (
+	// IgnoreNumbers leaves digits out of the analysis entirely
+	IgnoreNumbers NumberPolicy = iota
+
+	// NumbersAsWords counts each number as a one syllable word
+	NumbersAsWords
+
+	// SpokenNumbers counts each number as a word with as many syllables as
+	// it has when read aloud, so "1984" has 5 and "$5" has 3
+	SpokenNumbers
+)
+
+// AcronymPolicy controls how syllables are counted for acronyms
+type AcronymPolicy int
+
+// Policies for counting acronym syllables
+const (
+	// AcronymsAsWords counts syllables in acronyms like any other word
+	AcronymsAsWords AcronymPolicy = iota
+
+	// SpelledAcronyms counts acronyms as read letter by letter, so "FBI" has
+	// 3 syllables
+	SpelledAcronyms
+)
+
+// NormalisationPolicy controls how words that look the same but are written
+// with different characters, such as with accents composed or decomposed,
+// are made the same before they are analysed
+type NormalisationPolicy int
+
+// Policies for normalising words
+const (
+	// NormaliseNFC puts words in Unicode normalisation form C, and writes
+	// curly apostrophes as straight ones and Unicode hyphens as hyphen-minus
+	NormaliseNFC NormalisationPolicy = iota
+
+	// NormaliseNFKC also replaces compatibility characters, such as
+	// ligatures and full width letters, with their plain equivalents
+	NormaliseNFKC
+
+	// KeepUnnormalised analyses words as they are written
+	KeepUnnormalised
+)
+
 // AverageLettersPerWord returns the average number of letters per word in the
 // text
 func (r *Results) AverageLettersPerWord() float64 {
@@ -44,33 +259,34 @@ func (r *Results) AverageWordsPerSentence() float64 {
 	return float64(r.Words) / float64(r.Sentences)
 }
 
-// WordsWithAtLeastNSyllables returns the number of words with at least N
-// syllables, including or excluding proper nouns, in the text
-func (r *Results) WordsWithAtLeastNSyllables(n int, incProperNouns bool) int {
-	var total int
-	for sCount, wCount := range r.WordCountPerSyllableCountExcludingProperNouns {
-		if sCount >= n {
-			total += wCount
-		}
+// LexicalDensity returns the percentage of words in the text that are content
+// words rather than function words. It is NaN for languages without a list of
+// function words, as HasFunctionWords reports.
+func (r *Results) LexicalDensity() float64 {
+	if !r.HasFunctionWords() {
+		return math.NaN()
 	}
+	return (float64(r.ContentWords) / float64(r.Words)) * 100.0
+}
 
-	if !incProperNouns {
-		for sCount, wCount := range r.WordCountPerSyllableCountIncludingProperNouns {
-			if sCount >= n {
-				total -= wCount
-			}
-		}
-	}
+// HasFunctionWords reports whether function and content words were counted,
+// which they are only for languages with a list of function words
+func (r *Results) HasFunctionWords() bool {
+	_, ok := functionWordLists[r.Language().Code()]
+	return ok
+}
 
-	if total < 0 {
-		return 0
+// WordsWithAtLeastNSyllables returns the number of words with at least N
+// syllables, including or excluding proper nouns and acronyms, in the text
+func (r *Results) WordsWithAtLeastNSyllables(n int, incProperNouns bool) int {
+	if incProperNouns {
+		return r.WordsWithAtLeastNSyllablesExcluding(n, 0)
 	}
-
-	return total
+	return r.WordsWithAtLeastNSyllablesExcluding(n, ProperNoun|Acronym)
 }
 
 // PercentageWordsWithAtLeastNSyllables returns the percentage of words with at
-// least N syllables, including or excluding proper nouns, in the text
+// least N syllables, including or excluding proper nouns and acronyms, in the text
 func (r *Results) PercentageWordsWithAtLeastNSyllables(n int, incProperNouns bool) float64 {
 	return (float64(r.WordsWithAtLeastNSyllables(n, incProperNouns)) / float64(r.Words)) * 100.0
 }
@@ -101,7 +317,8 @@ func (r *Results) ColemanLiauIndex() float64 {
 	return (5.89 * (float64(r.Letters) / float64(r.Words))) - (0.3 * (sentences / float64(r.Words))) - 15.8
 }
 
-// SMOGIndex returns the SMOG index for the given text
+// SMOGIndex returns the SMOG index for the given text, approximated from every
+// sentence rather than the samples SampleSMOG takes
 func (r *Results) SMOGIndex() float64 {
 	sentences := float64(r.Sentences)
 	if sentences == 0 {
@@ -179,81 +396,345 @@ func syllableCount(word string) (sCount int) {
 	return
 }
 
-func analyseWord(word string, res *Results) {
+// clone returns a deep copy of the Results, including the state of an
+// analysis in progress
+func (r *Results) clone() *Results {
+	c := *r
+
+	c.WordCountPerSyllableCount = copyCounts(r.WordCountPerSyllableCount)
+	c.WordCountPerSyllableCountByClass = make(map[WordClass]map[int]int, len(r.WordCountPerSyllableCountByClass))
+	for class, counts := range r.WordCountPerSyllableCountByClass {
+		c.WordCountPerSyllableCountByClass[class] = copyCounts(counts)
+	}
+	c.WordCountPerLetterCount = copyCounts(r.WordCountPerLetterCount)
+	c.SentenceCountPerWordCount = copyCounts(r.SentenceCountPerWordCount)
+	if r.UnsupportedScripts != nil {
+		c.UnsupportedScripts = make(map[string]int, len(r.UnsupportedScripts))
+		for name, count := range r.UnsupportedScripts {
+			c.UnsupportedScripts[name] = count
+		}
+	}
+	c.LongSentences = append([]LongSentence(nil), r.LongSentences...)
+	c.Passives = append([]Passive(nil), r.Passives...)
+	c.Findings = append([]Finding(nil), r.Findings...)
+
+	if r.WordFrequencies != nil {
+		c.WordFrequencies = make(map[string]int, len(r.WordFrequencies))
+		for word, count := range r.WordFrequencies {
+			c.WordFrequencies[word] = count
+		}
+		c.wordIDs = make(map[string]uint32, len(r.wordIDs))
+		for word, id := range r.wordIDs {
+			c.wordIDs[word] = id
+		}
+	}
+	if r.BigramFrequencies != nil {
+		c.BigramFrequencies = make(map[string]int, len(r.BigramFrequencies))
+		for phrase, count := range r.BigramFrequencies {
+			c.BigramFrequencies[phrase] = count
+		}
+		c.TrigramFrequencies = make(map[string]int, len(r.TrigramFrequencies))
+		for phrase, count := range r.TrigramFrequencies {
+			c.TrigramFrequencies[phrase] = count
+		}
+	}
+
+	// the word sequence is only ever appended to, so both copies can share
+	// what has been seen so far
+	c.wordSequence = r.wordSequence[:len(r.wordSequence):len(r.wordSequence)]
+	c.phrase = append([]string(nil), r.phrase...)
+
+	c.properNouns = make(map[string]struct{}, len(r.properNouns))
+	for word := range r.properNouns {
+		c.properNouns[word] = struct{}{}
+	}
+
+	if r.lint != nil {
+		lint := *r.lint
+		lint.window = append([]lintWord(nil), r.lint.window...)
+		lint.key = nil
+		c.lint = &lint
+	}
+
+	return &c
+}
+
+// copyCounts returns a copy of a map of counts
+func copyCounts(counts map[int]int) map[int]int {
+	c := make(map[int]int, len(counts))
+	for k, v := range counts {
+		c[k] = v
+	}
+	return c
+}
+
+// analyseToken adds a token from the Tokenizer to the analysis
+func analyseToken(tok Token, res *Results) {
+	switch tok.Kind {
+	case WordToken:
+		for _, l := range tok.Text {
+			if unicode.IsLetter(l) {
+				res.Letters++
+				if isUnspacedLetter(l) {
+					analyseUnsupportedLetter(l, res)
+				}
+			}
+		}
+		analyseWord(tok.Text, tok.Start, tok.End, res)
+	case SpaceToken:
+		res.Spaces += utf8.RuneCountInString(tok.Text)
+	case PunctuationToken:
+		res.Punctuation++
+		res.phrase = res.phrase[:0]
+		if res.lint != nil {
+			endLintPhrase(res)
+		}
+	case SentenceEndToken:
+		endSentenceLength(tok.End, res)
+		res.Sentences++
+		endPassiveSentence(res)
+	}
+}
+
+func analyseWord(word string, start, end int, res *Results) {
 	res.Words++
 
-	sCount := syllableCount(word)
+	class := classifyWord(word, res.sentence.words == 0, res)
+	if class&CJKCharacter != 0 {
+		analyseCJK(word, res)
+	}
+	sCount := wordSyllables(word, class, res)
 	res.Syllables += sCount
 
-	if _, ok := res.WordCountPerSyllableCountExcludingProperNouns[sCount]; ok {
-		res.WordCountPerSyllableCountExcludingProperNouns[sCount]++
-	} else {
-		res.WordCountPerSyllableCountExcludingProperNouns[sCount] = 1
+	res.WordCountPerSyllableCount[sCount]++
+
+	if _, ok := res.WordCountPerSyllableCountByClass[class]; !ok {
+		res.WordCountPerSyllableCountByClass[class] = make(map[int]int)
 	}
+	res.WordCountPerSyllableCountByClass[class][sCount]++
 
-	if l, _ := utf8.DecodeRuneInString(word); unicode.IsUpper(l) {
-		if _, ok := res.WordCountPerSyllableCountIncludingProperNouns[sCount]; ok {
-			res.WordCountPerSyllableCountIncludingProperNouns[sCount]++
+	var letters int
+	for _, l := range word {
+		if unicode.IsLetter(l) {
+			letters++
+		}
+	}
+	res.WordCountPerLetterCount[letters]++
+
+	lower := strings.ToLower(word)
+	if list, ok := functionWordLists[res.opts.language().Code()]; ok {
+		if _, ok := list[lower]; ok {
+			res.FunctionWords++
 		} else {
-			res.WordCountPerSyllableCountIncludingProperNouns[sCount] = 1
+			res.ContentWords++
+		}
+	}
+
+	if res.WordFrequencies != nil {
+		res.WordFrequencies[lower]++
+		id, ok := res.wordIDs[lower]
+		if !ok {
+			id = uint32(len(res.wordIDs))
+			res.wordIDs[lower] = id
 		}
+		res.wordSequence = append(res.wordSequence, id)
+	}
+
+	if res.BigramFrequencies != nil {
+		analysePhrase(lower, res)
 	}
 
-	if _, ok := DaleChallWordList[word]; !ok {
-		matches := pluralRegexp.FindStringSubmatch(word)
-		if len(matches) >= 2 {
-			if _, ok := DaleChallWordList[matches[1]]; !ok {
-				res.DifficultWords++
+	analyseSentenceWord(start, sCount, res)
+	if isEnglish(res.opts.language()) {
+		analysePassive(word, lower, start, end, res)
+	}
+
+	if res.lint != nil {
+		analyseLint(word, lower, start, end, res)
+	}
+
+	if class&(Number|CJKCharacter) == 0 && isEnglish(res.opts.language()) && res.memo.isDifficultWord(word) {
+		res.DifficultWords++
+	}
+}
+
+// wordSyllables counts the syllables in a word, treating each part of a
+// compound word separately and following the number and acronym policies
+func wordSyllables(word string, class WordClass, res *Results) int {
+	opts := &res.opts
+	if class&Compound != 0 {
+		var sCount int
+		for _, part := range strings.Split(word, "-") {
+			if len(part) > 0 {
+				sCount += wordSyllables(part, classifyPart(part), res)
 			}
-		} else {
-			res.DifficultWords++
 		}
+		return sCount
 	}
+
+	switch {
+	case class&CJKCharacter != 0:
+		return 1
+	case class&Number != 0 && opts.Numbers == SpokenNumbers:
+		return numberSyllables(word)
+	case class&Number != 0:
+		return 1
+	case class&Acronym != 0 && opts.Acronyms == SpelledAcronyms:
+		return acronymSyllables(word)
+	}
+
+	return res.memo.syllableCount(word, opts.language())
 }
 
-// Analyse scans a reader and outputs an analysis
-func Analyse(r io.Reader) (res *Results, err error) {
-	scanner := bufio.NewScanner(r)
-	scanner.Split(bufio.ScanRunes)
-	res = &Results{}
-	res.WordCountPerSyllableCountExcludingProperNouns = make(map[int]int)
-	res.WordCountPerSyllableCountIncludingProperNouns = make(map[int]int)
-
-	var word string
-	var endWord bool
-	for scanner.Scan() {
-		str := scanner.Text()
-		letter, _ := utf8.DecodeRuneInString(str)
-		switch {
-		case unicode.IsLetter(letter):
-			res.Letters++
-			word += str
-			endWord = false
-		case unicode.IsSpace(letter):
-			endWord = true
-			res.Spaces++
-		case unicode.IsPunct(letter):
-			endWord = true
-			switch str {
-			case ".", "!", "?":
-				res.Sentences++
+// wordLookup works out the syllable counts and difficulty of words
+type wordLookup interface {
+	syllableCount(word string, lang Language) int
+	isDifficultWord(word string) bool
+}
+
+// maxMemoWords is the number of distinct words a wordMemo holds before it is
+// emptied, to bound its memory use on very large texts
+const maxMemoWords = 1 << 16
+
+// wordMemo remembers the syllable counts and difficulty of the words seen
+// during an analysis, which are expensive to work out. Unlike SyllableCache it
+// is only used by one analysis, so needs no locking.
+type wordMemo struct {
+	syllables map[string]int
+	difficult map[string]bool
+}
+
+func newWordMemo() *wordMemo {
+	return &wordMemo{
+		syllables: make(map[string]int),
+		difficult: make(map[string]bool),
+	}
+}
+
+// syllableCount returns lang.Syllables(word), working it out only the first
+// time the word is seen
+func (m *wordMemo) syllableCount(word string, lang Language) int {
+	key := syllableKey(word, lang)
+	if sCount, ok := m.syllables[key]; ok {
+		return sCount
+	}
+	if len(m.syllables) >= maxMemoWords {
+		m.syllables = make(map[string]int)
+	}
+	sCount := lang.Syllables(word)
+	m.syllables[key] = sCount
+	return sCount
+}
+
+// syllableKey returns the key a word's syllable count is cached under, which
+// is the word itself in English and prefixed with the language code otherwise
+func syllableKey(word string, lang Language) string {
+	if l, ok := lang.(*hyphenLanguage); ok {
+		return l.key + word
+	}
+	if lang == English {
+		return word
+	}
+	return lang.Code() + " " + word
+}
+
+// isDifficultWord returns isDifficultWord(word), working it out only the
+// first time the word is seen
+func (m *wordMemo) isDifficultWord(word string) bool {
+	if difficult, ok := m.difficult[word]; ok {
+		return difficult
+	}
+	if len(m.difficult) >= maxMemoWords {
+		m.difficult = make(map[string]bool)
+	}
+	difficult := isDifficultWord(word)
+	m.difficult[word] = difficult
+	return difficult
+}
+
+// isDifficultWord reports whether a word, or its singular form, is missing
+// from the Dale-Chall familiar word list. Compound words are difficult if any
+// of their parts are, and apostrophes are ignored.
+func isDifficultWord(word string) bool {
+	if strings.ContainsRune(word, '-') {
+		for _, part := range strings.Split(word, "-") {
+			if len(part) > 0 && classifyPart(part) != Number && isDifficultWord(part) {
+				return true
 			}
-			res.Punctuation++
 		}
+		return false
+	}
 
-		if endWord && len(word) > 0 {
-			analyseWord(word, res)
-			endWord = false
-			word = ""
+	word = strings.Map(func(r rune) rune {
+		if isApostrophe(r) {
+			return -1
 		}
+		return r
+	}, word)
+
+	if _, ok := DaleChallWordList[word]; ok {
+		return false
 	}
 
-	if len(word) > 0 {
-		analyseWord(word, res)
+	matches := pluralRegexp.FindStringSubmatch(word)
+	if len(matches) >= 2 {
+		_, ok := DaleChallWordList[matches[1]]
+		return !ok
 	}
 
-	// Return scanner error if any
-	err = scanner.Err()
+	return true
+}
 
-	return
+func analysePhrase(word string, res *Results) {
+	if len(res.phrase) == 3 {
+		res.phrase = append(res.phrase[:0], res.phrase[1:]...)
+	}
+	res.phrase = append(res.phrase, word)
+
+	if n := len(res.phrase); n >= 2 {
+		res.BigramFrequencies[strings.Join(res.phrase[n-2:], " ")]++
+		if n == 3 {
+			res.TrigramFrequencies[strings.Join(res.phrase, " ")]++
+		}
+	}
+}
+
+// Analyse scans a reader and outputs an analysis
+func Analyse(r io.Reader) (res *Results, err error) {
+	return AnalyseWithOptions(r, Options{})
+}
+
+// AnalyseWithOptions scans a reader and outputs an analysis, enabling the
+// optional parts of the analysis selected in opts
+func AnalyseWithOptions(r io.Reader, opts Options) (res *Results, err error) {
+	return AnalyseContext(context.Background(), r, opts)
+}
+
+// newResults returns empty Results ready for an analysis with the given
+// options
+func newResults(opts Options) *Results {
+	res := &Results{}
+	res.WordCountPerSyllableCount = make(map[int]int)
+	res.WordCountPerSyllableCountByClass = make(map[WordClass]map[int]int)
+	res.properNouns = make(map[string]struct{})
+	res.WordCountPerLetterCount = make(map[int]int)
+	res.SentenceCountPerWordCount = make(map[int]int)
+	if opts.Cache != nil {
+		res.memo = opts.Cache
+	} else {
+		res.memo = newWordMemo()
+	}
+	res.opts = opts
+	if opts.WordFrequencies {
+		res.WordFrequencies = make(map[string]int)
+		res.wordIDs = make(map[string]uint32)
+	}
+	if opts.NGrams {
+		res.BigramFrequencies = make(map[string]int)
+		res.TrigramFrequencies = make(map[string]int)
+	}
+	if opts.Linter != nil {
+		res.lint = newLintState(opts.Linter)
+	}
+	return res
 }
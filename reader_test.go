@@ -1,6 +1,7 @@
 package textstats
 
 import (
+	"bytes"
 	"errors"
 	"strings"
 	"testing"
@@ -41,6 +42,24 @@ func (s *AnalyseSuite) TestAverageWordsPerSentence() {
 	s.Equal(17.25, res.AverageWordsPerSentence())
 }
 
+func (s *AnalyseSuite) TestFunctionWordCount() {
+	res, _ := Analyse(strings.NewReader(qbf))
+	s.Equal(3, res.FunctionWords)
+	s.Equal(6, res.ContentWords)
+
+	res, _ = Analyse(strings.NewReader(rep))
+	s.Equal(7, res.FunctionWords)
+	s.Equal(6, res.ContentWords)
+}
+
+func (s *AnalyseSuite) TestLexicalDensity() {
+	res, _ := Analyse(strings.NewReader(qbf))
+	s.Equal(66.66666666666666, res.LexicalDensity())
+
+	res, _ = Analyse(strings.NewReader(lorem))
+	s.Equal(94.20289855072464, res.LexicalDensity())
+}
+
 func (s *AnalyseSuite) TestWordsWithAtLeastNSyllables() {
 	res, _ := Analyse(strings.NewReader(hw))
 	s.Equal(6, res.WordsWithAtLeastNSyllables(0, true))
@@ -50,9 +69,9 @@ func (s *AnalyseSuite) TestWordsWithAtLeastNSyllables() {
 	s.Equal(1, res.WordsWithAtLeastNSyllables(4, true))
 	s.Equal(0, res.WordsWithAtLeastNSyllables(5, true))
 
-	s.Equal(4, res.WordsWithAtLeastNSyllables(0, false))
-	s.Equal(4, res.WordsWithAtLeastNSyllables(1, false))
-	s.Equal(2, res.WordsWithAtLeastNSyllables(2, false))
+	s.Equal(5, res.WordsWithAtLeastNSyllables(0, false))
+	s.Equal(5, res.WordsWithAtLeastNSyllables(1, false))
+	s.Equal(3, res.WordsWithAtLeastNSyllables(2, false))
 	s.Equal(2, res.WordsWithAtLeastNSyllables(3, false))
 	s.Equal(1, res.WordsWithAtLeastNSyllables(4, false))
 	s.Equal(0, res.WordsWithAtLeastNSyllables(5, false))
@@ -67,10 +86,10 @@ func (s *AnalyseSuite) TestPercentageWordsWithAtLeastNSyllables() {
 	s.Equal(16.666666666666664, res.PercentageWordsWithAtLeastNSyllables(4, true))
 	s.Equal(0.0, res.PercentageWordsWithAtLeastNSyllables(5, true))
 
-	s.Equal(66.66666666666666, res.PercentageWordsWithAtLeastNSyllables(0, false))
-	s.Equal(66.66666666666666, res.PercentageWordsWithAtLeastNSyllables(1, false))
+	s.Equal(83.33333333333334, res.PercentageWordsWithAtLeastNSyllables(0, false))
+	s.Equal(83.33333333333334, res.PercentageWordsWithAtLeastNSyllables(1, false))
 	s.Equal(33.33333333333333, res.PercentageWordsWithAtLeastNSyllables(3, false))
-	s.Equal(33.33333333333333, res.PercentageWordsWithAtLeastNSyllables(2, false))
+	s.Equal(50.0, res.PercentageWordsWithAtLeastNSyllables(2, false))
 	s.Equal(16.666666666666664, res.PercentageWordsWithAtLeastNSyllables(4, false))
 	s.Equal(0.0, res.PercentageWordsWithAtLeastNSyllables(5, false))
 }
@@ -85,6 +104,51 @@ func (s *AnalyseSuite) TestLetterCount() {
 	s.Equal(35, res.Letters)
 }
 
+func (s *AnalyseSuite) TestWordCountPerLetterCount() {
+	res, _ := Analyse(strings.NewReader(qbf))
+	s.Equal(map[int]int{3: 4, 4: 2, 5: 3}, res.WordCountPerLetterCount)
+}
+
+func (s *AnalyseSuite) TestIgnoreNumbers() {
+	res, _ := Analyse(strings.NewReader(nums))
+	s.Equal(14, res.Words)
+	s.Equal(3, res.Sentences)
+	s.Equal(0, res.WordsOfClass(Number))
+}
+
+func (s *AnalyseSuite) TestNumbersAsWords() {
+	res, _ := AnalyseWithOptions(strings.NewReader(nums), Options{Numbers: NumbersAsWords})
+	s.Equal(17, res.Words)
+	s.Equal(2, res.Sentences)
+	s.Equal(4, res.WordsOfClass(Number))
+
+	// numbers are never difficult words
+	res, _ = AnalyseWithOptions(strings.NewReader("about $5 in 2024 or 3.14"), Options{Numbers: NumbersAsWords})
+	s.Equal(6, res.Words)
+	s.Equal(0, res.DifficultWords)
+}
+
+func (s *AnalyseSuite) TestSpokenNumbersAndAcronyms() {
+	plain, _ := AnalyseWithOptions(strings.NewReader(nums), Options{Numbers: NumbersAsWords})
+	spoken, _ := AnalyseWithOptions(strings.NewReader(nums), Options{Numbers: SpokenNumbers, Acronyms: SpelledAcronyms})
+	s.Equal(plain.Words, spoken.Words)
+	s.Equal(23, plain.Syllables)
+	s.Equal(38, spoken.Syllables)
+}
+
+func (s *AnalyseSuite) TestCompoundWords() {
+	res, _ := AnalyseWithOptions(strings.NewReader(nums), Options{WordFrequencies: true})
+	s.Contains(res.WordFrequencies, "don't")
+	s.Contains(res.WordFrequencies, "well-known")
+	s.Equal(1, res.WordsOfClass(Compound))
+
+	res, _ = AnalyseWithOptions(strings.NewReader(nums), Options{WordFrequencies: true, SplitCompounds: true})
+	s.Contains(res.WordFrequencies, "don")
+	s.Contains(res.WordFrequencies, "known")
+	s.Equal(0, res.WordsOfClass(Compound))
+	s.Equal(17, res.Words)
+}
+
 func (s *AnalyseSuite) TestSentenceCount() {
 	res, _ := Analyse(strings.NewReader(lorem))
 	s.Equal(4, res.Sentences)
@@ -107,7 +171,7 @@ func (s *AnalyseSuite) TestFleschKincaidGradeLevel() {
 
 func (s *AnalyseSuite) TestGunningFogScore() {
 	res, _ := Analyse(strings.NewReader(lorem))
-	s.Equal(19.073913043478264, res.GunningFogScore())
+	s.Equal(19.653623188405795, res.GunningFogScore())
 }
 
 func (s *AnalyseSuite) TestColemanLiauIndex() {
@@ -133,3 +197,24 @@ func (s *AnalyseSuite) TestDaleChallReadabilityScore() {
 func TestAnalyseMethods(t *testing.T) {
 	suite.Run(t, new(AnalyseSuite))
 }
+
+func BenchmarkAnalyse(b *testing.B) {
+	b.SetBytes(int64(len(benchCorpus)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := Analyse(bytes.NewReader(benchCorpus)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkAnalyseWithOptions(b *testing.B) {
+	opts := Options{WordFrequencies: true, NGrams: true, Linter: NewLinter(), Numbers: SpokenNumbers}
+	b.SetBytes(int64(len(benchCorpus)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := AnalyseWithOptions(bytes.NewReader(benchCorpus), opts); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
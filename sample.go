@@ -0,0 +1,233 @@
+package textstats
+
+import (
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+)
+
+// smogSampleSentences is the number of consecutive sentences taken from each
+// of the start, middle and end of a text for SMOG
+const smogSampleSentences = 10
+
+// fryPassageWords is the number of words in each Fry passage
+const fryPassageWords = 100
+
+// fryPassages is the number of passages averaged for Fry
+const fryPassages = 3
+
+// errShortText is returned when a text is too short for Fry's procedure
+var errShortText = errors.New("textstats: text has fewer than 100 words")
+
+// Span is a part of a text, given by its byte offsets
+type Span struct {
+	Start int
+	End   int
+}
+
+// SMOGSample is the result of McLaughlin's SMOG procedure. Texts with 30 or
+// fewer sentences are sampled whole and their polysyllable count scaled up to
+// 30 sentences.
+type SMOGSample struct {
+	// Sentences are the sentences sampled, in the order they occur
+	Sentences []Span
+
+	// Polysyllables is the number of words in the sample with three or more
+	// syllables, counting repeated words each time
+	Polysyllables int
+
+	// Grade is 1.0430 * sqrt(Polysyllables * 30 / len(Sentences)) + 3.1291
+	Grade float64
+}
+
+// FryPassage is one of the passages sampled by Fry's procedure
+type FryPassage struct {
+	Span
+
+	// Sentences is the number of sentences in the passage, with the part of
+	// the last sentence that falls within it estimated to the nearest tenth
+	Sentences float64
+
+	Syllables int
+}
+
+// FrySample is the result of Fry's procedure. Fry's readability graph has
+// no formula, so rather than a grade it gives the coordinates at which to
+// read the grade from the graph.
+type FrySample struct {
+	Passages []FryPassage
+
+	// SentencesPer100Words and SyllablesPer100Words are the averages over
+	// the passages, which are the graph's Y and X axes
+	SentencesPer100Words float64
+	SyllablesPer100Words float64
+}
+
+// sampleWord is a word found while collecting sentences for sampling
+type sampleWord struct {
+	Span
+	syllables int
+}
+
+// sampleSentence is a sentence found while collecting sentences for sampling
+type sampleSentence struct {
+	Span
+	words []sampleWord
+}
+
+// collectSentences splits a text into sentences of words along with their
+// syllable counts. Sentences without words are left out.
+func collectSentences(r io.Reader, opts Options) ([]sampleSentence, error) {
+	res := newResults(opts)
+
+	var sentences []sampleSentence
+	var current sampleSentence
+	t := TokenizeWithOptions(r, opts)
+	for t.Next() {
+		tok := t.Token()
+		syllables := res.Syllables
+		analyseToken(tok, res)
+
+		switch tok.Kind {
+		case WordToken:
+			if len(current.words) == 0 {
+				current.Start = tok.Start
+			}
+			current.words = append(current.words, sampleWord{
+				Span:      Span{tok.Start, tok.End},
+				syllables: res.Syllables - syllables,
+			})
+			current.End = tok.End
+		case SentenceEndToken:
+			if len(current.words) > 0 {
+				current.End = tok.End
+				sentences = append(sentences, current)
+				current = sampleSentence{}
+			}
+		}
+	}
+	if len(current.words) > 0 {
+		sentences = append(sentences, current)
+	}
+
+	return sentences, t.Err()
+}
+
+// SampleSMOG scans a reader and applies McLaughlin's SMOG procedure to it,
+// taking 10 consecutive sentences from each of the start, middle and end
+// thirds of the text. Where in each third the sentences are taken from is
+// picked at random, so the same seed always picks the same sample.
+//
+// Unlike SMOGIndex, which approximates SMOG from every sentence in the text,
+// this follows the published procedure.
+func SampleSMOG(r io.Reader, seed int64, opts Options) (*SMOGSample, error) {
+	sentences, err := collectSentences(r, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	sampled := sentences
+	if n := len(sentences); n > 3*smogSampleSentences {
+		rnd := rand.New(rand.NewSource(seed))
+		sampled = nil
+		for third := 0; third < 3; third++ {
+			first, last := third*n/3, (third+1)*n/3
+			start := first + rnd.Intn(last-first-smogSampleSentences+1)
+			sampled = append(sampled, sentences[start:start+smogSampleSentences]...)
+		}
+	}
+
+	sample := &SMOGSample{}
+	for _, sentence := range sampled {
+		sample.Sentences = append(sample.Sentences, sentence.Span)
+		for _, word := range sentence.words {
+			if word.syllables >= 3 {
+				sample.Polysyllables++
+			}
+		}
+	}
+
+	if len(sampled) > 0 {
+		scaled := float64(sample.Polysyllables) * 3 * smogSampleSentences / float64(len(sampled))
+		sample.Grade = 1.0430*math.Sqrt(scaled) + 3.1291
+	}
+
+	return sample, nil
+}
+
+// SampleFry scans a reader and applies Fry's procedure to it, taking three
+// 100 word passages that each start at the beginning of a sentence, one from
+// each of the start, middle and end of the text. Which sentences they start
+// at is picked at random, so the same seed always picks the same passages.
+// Passages overlap when the text is too short to keep them apart.
+func SampleFry(r io.Reader, seed int64, opts Options) (*FrySample, error) {
+	sentences, err := collectSentences(r, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// a passage can start at any sentence with 100 words from its start to
+	// the end of the text
+	var candidates []int
+	var words int
+	for _, sentence := range sentences {
+		words += len(sentence.words)
+	}
+	var seen int
+	for i, sentence := range sentences {
+		if words-seen >= fryPassageWords {
+			candidates = append(candidates, i)
+		}
+		seen += len(sentence.words)
+	}
+	if len(candidates) == 0 {
+		return nil, errShortText
+	}
+
+	rnd := rand.New(rand.NewSource(seed))
+	sample := &FrySample{}
+	for part := 0; part < fryPassages; part++ {
+		first, last := part*len(candidates)/fryPassages, (part+1)*len(candidates)/fryPassages
+		if last == first {
+			last = first + 1
+		}
+		start := candidates[first+rnd.Intn(last-first)]
+
+		passage := fryPassage(sentences[start:])
+		sample.Passages = append(sample.Passages, passage)
+		sample.SentencesPer100Words += passage.Sentences / fryPassages
+		sample.SyllablesPer100Words += float64(passage.Syllables) / fryPassages
+	}
+
+	return sample, nil
+}
+
+// fryPassage measures the 100 word passage at the start of sentences
+func fryPassage(sentences []sampleSentence) FryPassage {
+	var passage FryPassage
+	passage.Start = sentences[0].Start
+
+	var words int
+	for _, sentence := range sentences {
+		for i, word := range sentence.words {
+			passage.Syllables += word.syllables
+			passage.End = word.End
+			words++
+
+			if words == fryPassageWords {
+				if i == len(sentence.words)-1 {
+					passage.End = sentence.End
+					passage.Sentences++
+				} else {
+					part := float64(i+1) / float64(len(sentence.words))
+					passage.Sentences += math.Round(part*10) / 10
+				}
+				return passage
+			}
+		}
+		passage.Sentences++
+	}
+
+	return passage
+}
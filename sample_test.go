@@ -0,0 +1,79 @@
+package textstats
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SampleSuite struct {
+	suite.Suite
+}
+
+func (s *SampleSuite) TestSampleSMOG() {
+	text := strings.Repeat(lorem+" "+pasv+" ", 5)
+
+	sample, err := SampleSMOG(strings.NewReader(text), 0, Options{})
+	s.NoError(err)
+	s.Len(sample.Sentences, 30)
+	s.Equal(Span{241, 349}, sample.Sentences[0])
+	s.Equal(Span{1190, 1211}, sample.Sentences[10])
+	s.Equal(Span{2313, 2323}, sample.Sentences[20])
+	s.Equal(81, sample.Polysyllables)
+	s.InDelta(1.0430*9+3.1291, sample.Grade, 1e-9)
+
+	// the same seed picks the same sample
+	again, _ := SampleSMOG(strings.NewReader(text), 0, Options{})
+	s.Equal(sample, again)
+
+	other, _ := SampleSMOG(strings.NewReader(text), 1, Options{})
+	s.NotEqual(sample.Sentences, other.Sentences)
+}
+
+func (s *SampleSuite) TestSampleSMOGShortText() {
+	// texts of 30 sentences or fewer are sampled whole and scaled up
+	sample, err := SampleSMOG(strings.NewReader(lorem), 0, Options{})
+	s.NoError(err)
+	s.Len(sample.Sentences, 4)
+	s.Equal(22, sample.Polysyllables)
+	s.Equal(16.52667757954773, sample.Grade)
+
+	sample, err = SampleSMOG(strings.NewReader(""), 0, Options{})
+	s.NoError(err)
+	s.Equal(0.0, sample.Grade)
+}
+
+func (s *SampleSuite) TestSampleFry() {
+	text := strings.Repeat(lorem+" "+pasv+" ", 5)
+
+	sample, err := SampleFry(strings.NewReader(text), 0, Options{})
+	s.NoError(err)
+	s.Equal([]FryPassage{
+		{Span: Span{464, 1088}, Sentences: 8.6, Syllables: 188},
+		{Span: Span{1101, 1721}, Sentences: 9.1, Syllables: 187},
+		{Span: Span{2168, 2807}, Sentences: 8.2, Syllables: 194},
+	}, sample.Passages)
+	s.InDelta(8.633333333333333, sample.SentencesPer100Words, 1e-9)
+	s.InDelta(189.66666666666669, sample.SyllablesPer100Words, 1e-9)
+
+	for _, passage := range sample.Passages {
+		res, _ := Analyse(strings.NewReader(text[passage.Start:passage.End]))
+		s.Equal(100, res.Words)
+	}
+}
+
+func (s *SampleSuite) TestSampleFryShortText() {
+	_, err := SampleFry(strings.NewReader(pasv), 0, Options{})
+	s.Error(err)
+
+	// passages overlap when there is only room for one
+	sample, err := SampleFry(strings.NewReader(strings.Repeat(qbf+". ", 12)), 0, Options{})
+	s.NoError(err)
+	s.Equal(sample.Passages[0], sample.Passages[2])
+	s.Equal(11.1, sample.SentencesPer100Words)
+}
+
+func TestSample(t *testing.T) {
+	suite.Run(t, new(SampleSuite))
+}
@@ -0,0 +1,129 @@
+package textstats
+
+import (
+	"math"
+	"sort"
+)
+
+// LongSentence is a sentence with more words than Options.LongSentenceWords
+type LongSentence struct {
+	// Sentence is the zero based index of the sentence
+	Sentence int
+
+	// Start and End are the byte offsets of the sentence in the text, from
+	// its first word up to and including its terminating punctuation
+	Start int
+	End   int
+
+	Words int
+}
+
+// sentenceState tracks the words in the current sentence
+type sentenceState struct {
+	words     int
+	syllables int
+	start     int
+
+	// index is the zero based index of the current sentence, counting only
+	// sentences with words, unlike Results.Sentences which also counts
+	// terminators such as each dot of an ellipsis
+	index int
+}
+
+// SentenceLengthStandardDeviation returns the population standard deviation
+// of the number of words per sentence in the text
+func (r *Results) SentenceLengthStandardDeviation() float64 {
+	var sentences, words float64
+	for length, count := range r.SentenceCountPerWordCount {
+		sentences += float64(count)
+		words += float64(length * count)
+	}
+	if sentences == 0 {
+		return 0
+	}
+
+	mean := words / sentences
+	var variance float64
+	for _, length := range r.sentenceLengths() {
+		diff := float64(length) - mean
+		variance += diff * diff * float64(r.SentenceCountPerWordCount[length])
+	}
+
+	return math.Sqrt(variance / sentences)
+}
+
+// SentenceLengthPercentile returns the number of words in a sentence at the
+// given percentile, between 0 and 100, of sentence lengths in the text using
+// the nearest rank method
+func (r *Results) SentenceLengthPercentile(p float64) int {
+	var sentences int
+	for _, count := range r.SentenceCountPerWordCount {
+		sentences += count
+	}
+	if sentences == 0 {
+		return 0
+	}
+
+	rank := int(math.Ceil(p / 100 * float64(sentences)))
+	if rank < 1 {
+		rank = 1
+	}
+
+	var seen int
+	lengths := r.sentenceLengths()
+	for _, length := range lengths {
+		seen += r.SentenceCountPerWordCount[length]
+		if seen >= rank {
+			return length
+		}
+	}
+
+	return lengths[len(lengths)-1]
+}
+
+// sentenceLengths returns the distinct sentence lengths in the text in
+// ascending order
+func (r *Results) sentenceLengths() []int {
+	lengths := make([]int, 0, len(r.SentenceCountPerWordCount))
+	for length := range r.SentenceCountPerWordCount {
+		lengths = append(lengths, length)
+	}
+	sort.Ints(lengths)
+	return lengths
+}
+
+// analyseSentenceWord counts a word starting at the given offset towards the
+// current sentence
+func analyseSentenceWord(start, syllables int, res *Results) {
+	if res.sentence.words == 0 {
+		res.sentence.start = start
+	}
+	res.sentence.words++
+	res.sentence.syllables += syllables
+}
+
+// endSentenceLength records the length of the sentence ending at the given
+// offset. Sentences without any words are ignored.
+func endSentenceLength(end int, res *Results) {
+	state := &res.sentence
+	if state.words == 0 {
+		return
+	}
+
+	res.SentenceCountPerWordCount[state.words]++
+	if limit := res.opts.LongSentenceWords; limit > 0 && state.words > limit {
+		res.LongSentences = append(res.LongSentences, LongSentence{
+			Sentence: state.index,
+			Start:    state.start,
+			End:      end,
+			Words:    state.words,
+		})
+	}
+	if res.lint != nil {
+		lintSentence(end, res)
+	}
+
+	state.words = 0
+	state.syllables = 0
+	state.index++
+}
@@ -0,0 +1,74 @@
+package textstats
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SentenceSuite struct {
+	suite.Suite
+}
+
+func (s *SentenceSuite) TestSentenceCountPerWordCount() {
+	res, _ := Analyse(strings.NewReader(lorem))
+	s.Equal(map[int]int{16: 1, 17: 2, 19: 1}, res.SentenceCountPerWordCount)
+
+	// unterminated text counts as a sentence, empty sentences do not
+	res, _ = Analyse(strings.NewReader("Wait... what? No way"))
+	s.Equal(map[int]int{1: 2, 2: 1}, res.SentenceCountPerWordCount)
+}
+
+func (s *SentenceSuite) TestSentenceLengthStandardDeviation() {
+	res, _ := Analyse(strings.NewReader(lorem))
+	s.Equal(1.0897247358851685, res.SentenceLengthStandardDeviation())
+
+	res, _ = Analyse(strings.NewReader(qbf))
+	s.Equal(0.0, res.SentenceLengthStandardDeviation())
+
+	res, _ = Analyse(strings.NewReader(""))
+	s.Equal(0.0, res.SentenceLengthStandardDeviation())
+}
+
+func (s *SentenceSuite) TestSentenceLengthPercentile() {
+	res, _ := Analyse(strings.NewReader(pasv))
+	s.Equal(3, res.SentenceLengthPercentile(0))
+	s.Equal(4, res.SentenceLengthPercentile(50))
+	s.Equal(7, res.SentenceLengthPercentile(75))
+	s.Equal(13, res.SentenceLengthPercentile(90))
+	s.Equal(13, res.SentenceLengthPercentile(100))
+
+	res, _ = Analyse(strings.NewReader(""))
+	s.Equal(0, res.SentenceLengthPercentile(50))
+}
+
+func (s *SentenceSuite) TestLongSentences() {
+	res, _ := Analyse(strings.NewReader(lorem))
+	s.Empty(res.LongSentences)
+
+	res, _ = AnalyseWithOptions(strings.NewReader(lorem), Options{LongSentenceWords: 17})
+	s.Equal([]LongSentence{{Sentence: 0, Start: 0, End: 126, Words: 19}}, res.LongSentences)
+	s.True(strings.HasPrefix(lorem[:126], "Lorem ipsum"))
+	s.True(strings.HasSuffix(lorem[:126], "magna aliqua."))
+}
+
+func (s *SentenceSuite) TestSentenceIndexesSkipEmptySentences() {
+	text := "Wait... The cake was quickly eaten by the considerably overweight canine..."
+	l := NewLinter()
+	l.MaxSentenceGrade = 8
+	res, _ := AnalyseWithOptions(strings.NewReader(text), Options{LongSentenceWords: 5, Linter: l})
+
+	s.Require().Len(res.LongSentences, 1)
+	s.Equal(1, res.LongSentences[0].Sentence)
+	s.Require().Len(res.Passives, 1)
+	s.Equal(1, res.Passives[0].Sentence)
+	s.Require().NotEmpty(res.Findings)
+	for _, f := range res.Findings {
+		s.Equal(1, f.Sentence, f.Kind)
+	}
+}
+
+func TestSentenceMethods(t *testing.T) {
+	suite.Run(t, new(SentenceSuite))
+}
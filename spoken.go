@@ -0,0 +1,198 @@
+package textstats
+
+import (
+	"strings"
+	"unicode"
+)
+
+// syllables in the spoken form of the numbers zero to nineteen
+var unitSyllables = [...]int{2, 1, 1, 1, 1, 1, 1, 2, 1, 1, 1, 3, 1, 2, 2, 2, 2, 3, 2, 2}
+
+// syllables in the spoken form of twenty, thirty, ..., ninety, indexed by the
+// tens digit
+var tensSyllables = [...]int{0, 0, 2, 2, 2, 2, 2, 3, 2, 2}
+
+// syllables in the spoken form of symbols attached to numbers
+var symbolSyllables = map[rune]int{
+	'$': 2, // dollars
+	'€': 2, // euros
+	'£': 1, // pounds
+	'¥': 1, // yen
+	'%': 2, // percent
+}
+
+const (
+	hundredSyllables = 2
+	scaleSyllables   = 2 // thousand, million, billion and trillion
+	pointSyllables   = 1
+	ohSyllables      = 1
+
+	// numbers with more digits than this are read digit by digit
+	maxCardinalDigits = 15
+)
+
+// acronymSyllables counts the syllables in an acronym read letter by letter
+func acronymSyllables(word string) int {
+	var sCount int
+	for _, l := range word {
+		switch {
+		case l == 'W' || l == 'w':
+			sCount += 3
+		case unicode.IsLetter(l):
+			sCount++
+		}
+	}
+	return sCount
+}
+
+// classifyPart returns the Number or Acronym class of one part of a compound
+// word
+func classifyPart(part string) WordClass {
+	if strings.IndexFunc(part, unicode.IsDigit) >= 0 {
+		return Number
+	}
+
+	var letters, upper int
+	for _, l := range part {
+		if unicode.IsLetter(l) {
+			letters++
+			if unicode.IsUpper(l) {
+				upper++
+			}
+		}
+	}
+	if letters >= 2 && upper == letters {
+		return Acronym
+	}
+
+	return 0
+}
+
+// numberSyllables counts the syllables in a number as it would be read aloud,
+// including any currency or percent sign and ordinal suffix, so "1984" is
+// read as "nineteen eighty-four" and "$5" as "five dollars". Numbers with
+// group separators, such as "1,500", are never read as years.
+func numberSyllables(word string) int {
+	var sCount int
+	var digits strings.Builder
+	var decimals string
+	var letters int
+	var grouped bool
+
+	for i, r := range word {
+		switch {
+		case r >= '0' && r <= '9':
+			digits.WriteRune(r)
+		case unicode.IsDigit(r):
+			// digits from other scripts are read one by one
+			sCount++
+		case r == '.':
+			decimals = word[i+1:]
+		case r == ',':
+			grouped = true
+		case unicode.IsLetter(r):
+			letters++
+		default:
+			sCount += symbolSyllables[r]
+		}
+		if decimals != "" {
+			break
+		}
+	}
+
+	sCount += integerSyllables(digits.String(), !grouped)
+
+	if decimals != "" {
+		sCount += pointSyllables
+		for _, r := range decimals {
+			if r >= '0' && r <= '9' {
+				sCount += unitSyllables[r-'0']
+			}
+		}
+	}
+
+	// ordinal suffixes such as "th" add no syllables, other letters are read
+	// out one by one
+	if letters > 2 {
+		sCount += letters
+	}
+
+	return sCount
+}
+
+// integerSyllables counts the syllables in a string of digits read aloud.
+// Four digit numbers are read as years if asYear is set.
+func integerSyllables(digits string, asYear bool) int {
+	if digits == "" {
+		return 0
+	}
+
+	if len(digits) > maxCardinalDigits || (len(digits) > 1 && digits[0] == '0') {
+		var sCount int
+		for _, d := range digits {
+			sCount += unitSyllables[d-'0']
+		}
+		return sCount
+	}
+
+	var n int
+	for _, d := range digits {
+		n = n*10 + int(d-'0')
+	}
+
+	if asYear && len(digits) == 4 && (n < 2000 || n >= 2010) {
+		high, low := n/100, n%100
+		switch {
+		case low == 0:
+			return below100Syllables(high) + hundredSyllables
+		case low < 10:
+			return below100Syllables(high) + ohSyllables + unitSyllables[low]
+		default:
+			return below100Syllables(high) + below100Syllables(low)
+		}
+	}
+
+	return cardinalSyllables(n)
+}
+
+// cardinalSyllables counts the syllables in n read as a cardinal number
+func cardinalSyllables(n int) int {
+	if n == 0 {
+		return unitSyllables[0]
+	}
+
+	var sCount int
+	for scale := 0; n > 0; scale++ {
+		if group := n % 1000; group > 0 {
+			sCount += below1000Syllables(group)
+			if scale > 0 {
+				sCount += scaleSyllables
+			}
+		}
+		n /= 1000
+	}
+	return sCount
+}
+
+func below1000Syllables(n int) int {
+	var sCount int
+	if hundreds := n / 100; hundreds > 0 {
+		sCount += unitSyllables[hundreds] + hundredSyllables
+	}
+	if rest := n % 100; rest > 0 {
+		sCount += below100Syllables(rest)
+	}
+	return sCount
+}
+
+func below100Syllables(n int) int {
+	if n < 20 {
+		return unitSyllables[n]
+	}
+
+	sCount := tensSyllables[n/10]
+	if n%10 > 0 {
+		sCount += unitSyllables[n%10]
+	}
+	return sCount
+}
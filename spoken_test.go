@@ -0,0 +1,56 @@
+package textstats
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SpokenSuite struct {
+	suite.Suite
+}
+
+func (s *SpokenSuite) TestNumberSyllables() {
+	numbers := map[string]int{
+		"0":       2,
+		"5":       1,
+		"17":      3,
+		"42":      3,
+		"100":     3,
+		"1900":    4,
+		"1905":    4,
+		"1984":    5,
+		"2000":    3,
+		"2005":    4,
+		"2024":    5,
+		"12345":   9,
+		"1,000":   3,
+		"1000000": 3,
+		"007":     6,
+		"3.14":    4,
+		"$5":      3,
+		"£20":     3,
+		"50%":     4,
+		"21st":    3,
+	}
+
+	for number, count := range numbers {
+		s.Equal(count, numberSyllables(number), fmt.Sprintf("%q should have %d syllables", number, count))
+	}
+}
+
+func (s *SpokenSuite) TestAcronymSyllables() {
+	s.Equal(3, acronymSyllables("FBI"))
+	s.Equal(5, acronymSyllables("WHO"))
+}
+
+func (s *SpokenSuite) TestClassifyPart() {
+	s.Equal(Number, classifyPart("19"))
+	s.Equal(Acronym, classifyPart("COVID"))
+	s.Equal(WordClass(0), classifyPart("known"))
+}
+
+func TestSpokenMethods(t *testing.T) {
+	suite.Run(t, new(SpokenSuite))
+}
@@ -23,15 +23,22 @@ func AverageWordsPerSentence(text string) float64 {
 	return res.AverageWordsPerSentence()
 }
 
+// LexicalDensity returns the percentage of words in the given text that are
+// content words rather than function words
+func LexicalDensity(text string) float64 {
+	res, _ := Analyse(strings.NewReader(text))
+	return res.LexicalDensity()
+}
+
 // WordsWithAtLeastNSyllables returns the number of words with at least N
-// syllables, including or excluding proper nouns, in the text
+// syllables, including or excluding proper nouns and acronyms, in the text
 func WordsWithAtLeastNSyllables(text string, n int, incProperNouns bool) int {
 	res, _ := Analyse(strings.NewReader(text))
 	return res.WordsWithAtLeastNSyllables(n, incProperNouns)
 }
 
 // PercentageWordsWithAtLeastNSyllables returns the percentage of words with at
-// least N syllables, including or excluding proper nouns, in the text
+// least N syllables, including or excluding proper nouns and acronyms, in the text
 func PercentageWordsWithAtLeastNSyllables(text string, n int, incProperNouns bool) float64 {
 	res, _ := Analyse(strings.NewReader(text))
 	return res.PercentageWordsWithAtLeastNSyllables(n, incProperNouns)
@@ -103,3 +110,34 @@ func DaleChallReadabilityScore(text string) float64 {
 	res, _ := Analyse(strings.NewReader(text))
 	return res.DaleChallReadabilityScore()
 }
+
+// TypeTokenRatio returns the ratio of distinct words to total words in the
+// given text
+func TypeTokenRatio(text string) float64 {
+	res, _ := AnalyseWithOptions(strings.NewReader(text), Options{WordFrequencies: true})
+	return res.TypeTokenRatio()
+}
+
+// RootTypeTokenRatio returns the root type-token ratio for the given text
+func RootTypeTokenRatio(text string) float64 {
+	res, _ := AnalyseWithOptions(strings.NewReader(text), Options{WordFrequencies: true})
+	return res.RootTypeTokenRatio()
+}
+
+// MTLD returns the measure of textual lexical diversity for the given text
+func MTLD(text string) float64 {
+	res, _ := AnalyseWithOptions(strings.NewReader(text), Options{WordFrequencies: true})
+	return res.MTLD()
+}
+
+// HDD returns the HD-D lexical diversity score for the given text
+func HDD(text string) float64 {
+	res, _ := AnalyseWithOptions(strings.NewReader(text), Options{WordFrequencies: true})
+	return res.HDD()
+}
+
+// YulesK returns Yule's characteristic K for the given text
+func YulesK(text string) float64 {
+	res, _ := AnalyseWithOptions(strings.NewReader(text), Options{WordFrequencies: true})
+	return res.YulesK()
+}
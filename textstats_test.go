@@ -24,6 +24,11 @@ func (s *StringSuite) TestAverageWordsPerSentence() {
 	s.Equal(17.25, AverageWordsPerSentence(lorem))
 }
 
+func (s *StringSuite) TestLexicalDensity() {
+	s.Equal(66.66666666666666, LexicalDensity(qbf))
+	s.Equal(46.15384615384615, LexicalDensity(rep))
+}
+
 func (s *StringSuite) TestWordsWithAtLeastNSyllables() {
 	s.Equal(6, WordsWithAtLeastNSyllables(hw, 0, true))
 	s.Equal(6, WordsWithAtLeastNSyllables(hw, 1, true))
@@ -32,9 +37,9 @@ func (s *StringSuite) TestWordsWithAtLeastNSyllables() {
 	s.Equal(1, WordsWithAtLeastNSyllables(hw, 4, true))
 	s.Equal(0, WordsWithAtLeastNSyllables(hw, 5, true))
 
-	s.Equal(4, WordsWithAtLeastNSyllables(hw, 0, false))
-	s.Equal(4, WordsWithAtLeastNSyllables(hw, 1, false))
-	s.Equal(2, WordsWithAtLeastNSyllables(hw, 2, false))
+	s.Equal(5, WordsWithAtLeastNSyllables(hw, 0, false))
+	s.Equal(5, WordsWithAtLeastNSyllables(hw, 1, false))
+	s.Equal(3, WordsWithAtLeastNSyllables(hw, 2, false))
 	s.Equal(2, WordsWithAtLeastNSyllables(hw, 3, false))
 	s.Equal(1, WordsWithAtLeastNSyllables(hw, 4, false))
 	s.Equal(0, WordsWithAtLeastNSyllables(hw, 5, false))
@@ -48,10 +53,10 @@ func (s *StringSuite) TestPercentageWordsWithAtLeastNSyllables() {
 	s.Equal(16.666666666666664, PercentageWordsWithAtLeastNSyllables(hw, 4, true))
 	s.Equal(0.0, PercentageWordsWithAtLeastNSyllables(hw, 5, true))
 
-	s.Equal(66.66666666666666, PercentageWordsWithAtLeastNSyllables(hw, 0, false))
-	s.Equal(66.66666666666666, PercentageWordsWithAtLeastNSyllables(hw, 1, false))
+	s.Equal(83.33333333333334, PercentageWordsWithAtLeastNSyllables(hw, 0, false))
+	s.Equal(83.33333333333334, PercentageWordsWithAtLeastNSyllables(hw, 1, false))
 	s.Equal(33.33333333333333, PercentageWordsWithAtLeastNSyllables(hw, 3, false))
-	s.Equal(33.33333333333333, PercentageWordsWithAtLeastNSyllables(hw, 2, false))
+	s.Equal(50.0, PercentageWordsWithAtLeastNSyllables(hw, 2, false))
 	s.Equal(16.666666666666664, PercentageWordsWithAtLeastNSyllables(hw, 4, false))
 	s.Equal(0.0, PercentageWordsWithAtLeastNSyllables(hw, 5, false))
 }
@@ -116,7 +121,7 @@ func (s *StringSuite) TestFleschKincaidGradeLevel() {
 }
 
 func (s *StringSuite) TestGunningFogScore() {
-	s.Equal(19.073913043478264, GunningFogScore(lorem))
+	s.Equal(19.653623188405795, GunningFogScore(lorem))
 }
 
 func (s *StringSuite) TestColemanLiauIndex() {
@@ -135,6 +140,26 @@ func (s *StringSuite) TestDaleChallReadabilityScore() {
 	s.Equal(5.837344444444444, DaleChallReadabilityScore(qbf))
 }
 
+func (s *StringSuite) TestTypeTokenRatio() {
+	s.Equal(0.8888888888888888, TypeTokenRatio(qbf))
+}
+
+func (s *StringSuite) TestRootTypeTokenRatio() {
+	s.Equal(2.6666666666666665, RootTypeTokenRatio(qbf))
+}
+
+func (s *StringSuite) TestMTLD() {
+	s.Equal(22.679999999999993, MTLD(qbf))
+}
+
+func (s *StringSuite) TestHDD() {
+	s.Equal(0.9405275413215478, HDD(lorem))
+}
+
+func (s *StringSuite) TestYulesK() {
+	s.Equal(246.91358024691357, YulesK(qbf))
+}
+
 func TestStringMethods(t *testing.T) {
 	suite.Run(t, new(StringSuite))
 }
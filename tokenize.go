@@ -0,0 +1,512 @@
+package textstats
+
+import (
+	"bytes"
+	"io"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// TokenKind identifies what a Token is
+type TokenKind int
+
+// Kinds of token produced by a Tokenizer
+const (
+	// WordToken is a word, as counted by the analysis
+	WordToken TokenKind = iota
+
+	// PunctuationToken is a single punctuation mark
+	PunctuationToken
+
+	// SpaceToken is a run of whitespace
+	SpaceToken
+
+	// SymbolToken is a symbol or other rune that isn't part of a word, such
+	// as a digit when numbers are ignored
+	SymbolToken
+
+	// SentenceEndToken is an empty token marking the end of a sentence,
+	// straight after the punctuation that ends it
+	SentenceEndToken
+)
+
+var tokenKindNames = [...]string{
+	"word",
+	"punctuation",
+	"space",
+	"symbol",
+	"sentence-end",
+}
+
+// String returns the name of the token kind
+func (k TokenKind) String() string {
+	if k < 0 || int(k) >= len(tokenKindNames) {
+		return "unknown"
+	}
+	return tokenKindNames[k]
+}
+
+// Token is a word, punctuation mark, run of whitespace, symbol or sentence
+// boundary found in a text. Runes skipped inside a word, such as digits when
+// numbers are ignored, fall within the word's span but are left out of its
+// Text.
+type Token struct {
+	Kind TokenKind
+	Text string
+
+	// Start and End are the byte offsets of the token in the text
+	Start int
+	End   int
+
+	// Line and Column are the one based line and rune column of Start
+	Line   int
+	Column int
+}
+
+// Tokenizer splits a text into the tokens the analysis is built on.
+// Successive calls to Next step through the tokens, in the style of
+// bufio.Scanner:
+//
+//	t := textstats.Tokenize(r)
+//	for t.Next() {
+//		tok := t.Token()
+//		...
+//	}
+//	if err := t.Err(); err != nil {
+//		...
+//	}
+type Tokenizer struct {
+	r   io.Reader
+	t   *tokenizer
+	buf []byte
+
+	queue []Token
+	head  int
+	tok   Token
+	err   error
+	done  bool
+}
+
+// tokenizerBufferSize is how much of the text a Tokenizer reads at a time
+const tokenizerBufferSize = 4096
+
+// Tokenize returns a Tokenizer reading from r that splits words the same way
+// as Analyse
+func Tokenize(r io.Reader) *Tokenizer {
+	return TokenizeWithOptions(r, Options{})
+}
+
+// TokenizeWithOptions returns a Tokenizer reading from r that splits words the
+// same way as AnalyseWithOptions. Only the Numbers, SplitCompounds and
+// Normalisation options affect tokenization.
+func TokenizeWithOptions(r io.Reader, opts Options) *Tokenizer {
+	tz := &Tokenizer{r: r, buf: make([]byte, tokenizerBufferSize)}
+	tz.t = newTokenizer(opts, func(tok Token) {
+		tz.queue = append(tz.queue, tok)
+	})
+	return tz
+}
+
+// Next advances to the next token, which is then available from Token. It
+// returns false when the text ends or reading it fails.
+func (tz *Tokenizer) Next() bool {
+	if tz.head == len(tz.queue) {
+		tz.queue, tz.head = tz.queue[:0], 0
+	}
+
+	for tz.head == len(tz.queue) {
+		if tz.done {
+			return false
+		}
+
+		n, err := tz.r.Read(tz.buf)
+		tz.t.write(tz.buf[:n])
+		if err != nil {
+			if err != io.EOF {
+				tz.err = err
+			}
+			tz.t.close()
+			tz.done = true
+		}
+	}
+
+	tz.tok = tz.queue[tz.head]
+	tz.head++
+	return true
+}
+
+// Token returns the token found by the most recent call to Next
+func (tz *Tokenizer) Token() Token {
+	return tz.tok
+}
+
+// Err returns the first error other than io.EOF met while reading the text
+func (tz *Tokenizer) Err() error {
+	return tz.err
+}
+
+// position is a location in the text being tokenized
+type position struct {
+	offset, line, column int
+}
+
+// runeClass is how the tokenizer treats a rune
+type runeClass uint8
+
+// Classes of rune, in the order they are checked
+const (
+	letterRune runeClass = iota
+	cjkRune
+	digitRune
+	currencyRune
+	spaceRune
+	punctRune
+	markRune
+	formatRune
+	otherRune
+)
+
+// classifyRune works out the class of a rune
+func classifyRune(r rune) runeClass {
+	switch {
+	case unicode.IsLetter(r):
+		if isCJK(r) {
+			return cjkRune
+		}
+		return letterRune
+	case unicode.IsDigit(r):
+		return digitRune
+	case unicode.Is(unicode.Sc, r):
+		return currencyRune
+	case unicode.IsSpace(r):
+		return spaceRune
+	case unicode.IsPunct(r):
+		return punctRune
+	case unicode.IsMark(r):
+		return markRune
+	case unicode.Is(unicode.Cf, r):
+		return formatRune
+	}
+	return otherRune
+}
+
+// asciiClasses caches the class of every ASCII rune
+var asciiClasses = func() (classes [utf8.RuneSelf]runeClass) {
+	for r := range classes {
+		classes[r] = classifyRune(rune(r))
+	}
+	return
+}()
+
+// tokenizer is the push based core of Tokenizer. Text is written to it in
+// chunks of any size and it calls emit with each token as soon as it is
+// complete. Hyphens, apostrophes and the separators inside numbers only join
+// words when followed by something that can continue the word, so they are
+// held back until the next rune arrives.
+type tokenizer struct {
+	numbers bool
+	split   bool
+	emit    func(Token)
+	pos     position
+
+	// normalise is set when words are put in form and their apostrophes and
+	// hyphens are made plain
+	normalise bool
+	form      norm.Form
+
+	word      []byte
+	wordStart position
+
+	pending   rune
+	pendingAt position
+
+	space      []byte
+	spaceStart position
+
+	// partial holds the first bytes of a rune split across writes
+	partial  [utf8.UTFMax]byte
+	npartial int
+}
+
+// newTokenizer returns a tokenizer following the Numbers, SplitCompounds and
+// Normalisation options that passes each token to emit
+func newTokenizer(opts Options, emit func(Token)) *tokenizer {
+	t := &tokenizer{
+		numbers:   opts.Numbers != IgnoreNumbers,
+		split:     opts.SplitCompounds,
+		emit:      emit,
+		pos:       position{line: 1, column: 1},
+		normalise: opts.Normalisation != KeepUnnormalised,
+		form:      norm.NFC,
+	}
+	if opts.Normalisation == NormaliseNFKC {
+		t.form = norm.NFKC
+	}
+	return t
+}
+
+// clone returns a copy of the tokenizer, part way through the same text, that
+// passes its tokens to emit
+func (t *tokenizer) clone(emit func(Token)) *tokenizer {
+	c := *t
+	c.emit = emit
+	c.word = append([]byte(nil), t.word...)
+	c.space = append([]byte(nil), t.space...)
+	return &c
+}
+
+// write tokenizes the next chunk of the text
+func (t *tokenizer) write(p []byte) {
+	// finish off a rune split across writes
+	for t.npartial > 0 && len(p) > 0 {
+		t.partial[t.npartial] = p[0]
+		t.npartial++
+		p = p[1:]
+		if utf8.FullRune(t.partial[:t.npartial]) {
+			r, size := utf8.DecodeRune(t.partial[:t.npartial])
+			p = append(t.partial[size:t.npartial:t.npartial], p...)
+			t.npartial = 0
+			t.step(r, size)
+		}
+	}
+
+	for i := 0; i < len(p); {
+		if b := p[i]; b < utf8.RuneSelf {
+			t.step(rune(b), 1)
+			i++
+			continue
+		}
+
+		if !utf8.FullRune(p[i:]) {
+			t.npartial = copy(t.partial[:], p[i:])
+			return
+		}
+
+		r, size := utf8.DecodeRune(p[i:])
+		t.step(r, size)
+		i += size
+	}
+}
+
+// close flushes any word, whitespace or punctuation still held when the text
+// ends
+func (t *tokenizer) close() {
+	if t.npartial > 0 {
+		// an incomplete rune at the end of the text is invalid
+		n := t.npartial
+		t.npartial = 0
+		for i := 0; i < n; i++ {
+			t.step(utf8.RuneError, 1)
+		}
+	}
+
+	if t.pending != 0 {
+		t.endWord(t.pendingAt.offset)
+		t.punctuation(t.pending, t.pendingAt)
+		t.pending = 0
+	}
+	t.endWord(t.pos.offset)
+	t.endSpace(t.pos.offset)
+}
+
+// step processes the next rune of the text, which takes up size bytes
+func (t *tokenizer) step(r rune, size int) {
+	at := t.pos
+	t.pos.offset += size
+	if r == '\n' {
+		t.pos.line++
+		t.pos.column = 1
+	} else {
+		t.pos.column++
+	}
+
+	var class runeClass
+	if r < utf8.RuneSelf {
+		class = asciiClasses[r]
+	} else {
+		class = classifyRune(r)
+		if class == formatRune {
+			// invisible formatting characters are ignored
+			return
+		}
+	}
+
+	if t.pending != 0 {
+		p := t.pending
+		t.pending = 0
+		if t.joins(p, r) {
+			if t.normalise {
+				p = plainJoiner(p)
+			}
+			t.word = utf8.AppendRune(t.word, p)
+		} else {
+			t.endWord(t.pendingAt.offset)
+			t.punctuation(p, t.pendingAt)
+		}
+	}
+
+	if class != spaceRune {
+		t.endSpace(at.offset)
+	}
+
+	switch class {
+	case letterRune:
+		t.add(r, at)
+	case cjkRune:
+		// Chinese and Japanese characters are words of their own
+		t.endWord(at.offset)
+		t.token(WordToken, string(r), at, t.pos.offset)
+	case digitRune, currencyRune:
+		// currency symbols belong to the amount they are attached to
+		if t.numbers {
+			t.add(r, at)
+		} else {
+			t.symbol(r, at)
+		}
+	case spaceRune:
+		t.endWord(at.offset)
+		if len(t.space) == 0 {
+			t.spaceStart = at
+		}
+		t.space = utf8.AppendRune(t.space, r)
+	case punctRune:
+		if len(t.word) > 0 {
+			switch {
+			case r == '%' && t.numbers && t.endsInDigit():
+				t.word = append(t.word, '%')
+				return
+			case (r == '.' || r == ',') && t.numbers && t.endsInDigit(),
+				(t.isHyphen(r) || isApostrophe(r)) && !t.split:
+				t.pending = r
+				t.pendingAt = at
+				return
+			}
+		}
+		t.endWord(at.offset)
+		t.punctuation(r, at)
+	case markRune:
+		// combining marks belong to the letter before them
+		if len(t.word) > 0 {
+			t.word = utf8.AppendRune(t.word, r)
+		} else {
+			t.symbol(r, at)
+		}
+	default:
+		t.symbol(r, at)
+	}
+}
+
+// isApostrophe reports whether r is a straight or curly apostrophe
+func isApostrophe(r rune) bool {
+	return r == '\'' || r == '’'
+}
+
+// isHyphen reports whether r is a hyphen that can join the parts of a word.
+// Unicode's own hyphens only join words when they are normalised.
+func (t *tokenizer) isHyphen(r rune) bool {
+	return r == '-' || (t.normalise && (r == '‐' || r == '‑'))
+}
+
+// plainJoiner returns the ASCII apostrophe or hyphen for one that joins the
+// parts of a word
+func plainJoiner(r rune) rune {
+	switch r {
+	case '’':
+		return '\''
+	case '‐', '‑':
+		return '-'
+	}
+	return r
+}
+
+// endsInDigit reports whether the current word ends with a digit
+func (t *tokenizer) endsInDigit() bool {
+	r, _ := utf8.DecodeLastRune(t.word)
+	return unicode.IsDigit(r)
+}
+
+// joins reports whether the held back rune p joins the current word to the
+// rune r following it
+func (t *tokenizer) joins(p, r rune) bool {
+	switch {
+	case p == '.' || p == ',':
+		return unicode.IsDigit(r)
+	case unicode.IsLetter(r):
+		return !isCJK(r)
+	}
+	return unicode.IsDigit(r) && t.numbers
+}
+
+// token emits a token of the given kind spanning from start to end
+func (t *tokenizer) token(kind TokenKind, text string, start position, end int) {
+	t.emit(Token{
+		Kind:   kind,
+		Text:   text,
+		Start:  start.offset,
+		End:    end,
+		Line:   start.line,
+		Column: start.column,
+	})
+}
+
+// add appends a rune found at the given position to the current word
+func (t *tokenizer) add(r rune, at position) {
+	if len(t.word) == 0 {
+		t.wordStart = at
+	}
+	t.word = utf8.AppendRune(t.word, r)
+}
+
+// endWord emits the current word, which ends at the given offset. Words made
+// up only of symbols are emitted as symbols.
+func (t *tokenizer) endWord(end int) {
+	if len(t.word) == 0 {
+		return
+	}
+
+	kind := SymbolToken
+	if bytes.IndexFunc(t.word, func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsDigit(r)
+	}) >= 0 {
+		kind = WordToken
+	}
+
+	text := t.word
+	if t.normalise && !t.form.IsNormal(text) {
+		text = t.form.Bytes(text)
+	}
+	t.token(kind, string(text), t.wordStart, end)
+	t.word = t.word[:0]
+}
+
+// endSpace emits the current run of whitespace, which ends at the given offset
+func (t *tokenizer) endSpace(end int) {
+	if len(t.space) == 0 {
+		return
+	}
+	t.token(SpaceToken, string(t.space), t.spaceStart, end)
+	t.space = t.space[:0]
+}
+
+// symbol emits the rune just read, which isn't part of any word. Symbols inside
+// a word are skipped over.
+func (t *tokenizer) symbol(r rune, at position) {
+	if len(t.word) > 0 {
+		return
+	}
+	t.token(SymbolToken, string(r), at, t.pos.offset)
+}
+
+// punctuation emits a punctuation mark found at the given position, followed
+// by the end of the sentence if it is a sentence terminator
+func (t *tokenizer) punctuation(r rune, at position) {
+	end := at.offset + utf8.RuneLen(r)
+	t.token(PunctuationToken, string(r), at, end)
+
+	switch r {
+	case '.', '!', '?', '。', '！', '？', '｡', '．':
+		t.token(SentenceEndToken, "", position{end, at.line, at.column + 1}, end)
+	}
+}
@@ -0,0 +1,149 @@
+package textstats
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TokenizeSuite struct {
+	suite.Suite
+}
+
+func tokens(t *Tokenizer) []Token {
+	var toks []Token
+	for t.Next() {
+		toks = append(toks, t.Token())
+	}
+	return toks
+}
+
+func (s *TokenizeSuite) TestTokenize() {
+	toks := tokens(Tokenize(strings.NewReader("It's well-known.\n  Yes!")))
+	s.Equal([]Token{
+		{Kind: WordToken, Text: "It's", Start: 0, End: 4, Line: 1, Column: 1},
+		{Kind: SpaceToken, Text: " ", Start: 4, End: 5, Line: 1, Column: 5},
+		{Kind: WordToken, Text: "well-known", Start: 5, End: 15, Line: 1, Column: 6},
+		{Kind: PunctuationToken, Text: ".", Start: 15, End: 16, Line: 1, Column: 16},
+		{Kind: SentenceEndToken, Text: "", Start: 16, End: 16, Line: 1, Column: 17},
+		{Kind: SpaceToken, Text: "\n  ", Start: 16, End: 19, Line: 1, Column: 17},
+		{Kind: WordToken, Text: "Yes", Start: 19, End: 22, Line: 2, Column: 3},
+		{Kind: PunctuationToken, Text: "!", Start: 22, End: 23, Line: 2, Column: 6},
+		{Kind: SentenceEndToken, Text: "", Start: 23, End: 23, Line: 2, Column: 7},
+	}, toks)
+}
+
+func (s *TokenizeSuite) TestTokenizeNumbers() {
+	var kinds []TokenKind
+	var texts []string
+	for _, tok := range tokens(Tokenize(strings.NewReader("£5 + 3.14"))) {
+		kinds = append(kinds, tok.Kind)
+		texts = append(texts, tok.Text)
+	}
+	s.Equal([]string{"£", "5", " ", "+", " ", "3", ".", "", "1", "4"}, texts)
+	s.Equal(SymbolToken, kinds[0])
+	s.Equal(SentenceEndToken, kinds[7])
+
+	texts = texts[:0]
+	for _, tok := range tokens(TokenizeWithOptions(strings.NewReader("£5 + 3.14"), Options{Numbers: SpokenNumbers})) {
+		texts = append(texts, tok.Text)
+	}
+	s.Equal([]string{"£5", " ", "+", " ", "3.14"}, texts)
+}
+
+func (s *TokenizeSuite) TestTokenizeMatchesAnalyse() {
+	for _, text := range []string{hw, lint, nums, pasv, lorem} {
+		res, _ := Analyse(strings.NewReader(text))
+
+		var words, sentences, punctuation int
+		for _, tok := range tokens(Tokenize(strings.NewReader(text))) {
+			s.Equal(text[tok.Start:tok.End], tok.Text)
+			switch tok.Kind {
+			case WordToken:
+				words++
+			case SentenceEndToken:
+				sentences++
+			case PunctuationToken:
+				punctuation++
+			}
+		}
+		s.Equal(res.Words, words)
+		s.Equal(res.Sentences, sentences)
+		s.Equal(res.Punctuation, punctuation)
+	}
+}
+
+func (s *TokenizeSuite) TestTokenizeSplitRunes() {
+	text := "Café – “naïve” résumé’s ünïcödé…\xff"
+	s.Equal(
+		tokens(Tokenize(strings.NewReader(text))),
+		tokens(Tokenize(iotest.OneByteReader(strings.NewReader(text)))),
+	)
+
+	res, _ := Analyse(iotest.OneByteReader(strings.NewReader(text)))
+	s.Equal(4, res.Words)
+}
+
+func (s *TokenizeSuite) TestTokenizeNormalises() {
+	words := func(text string, opts Options) []string {
+		var words []string
+		for _, tok := range tokens(TokenizeWithOptions(strings.NewReader(text), opts)) {
+			if tok.Kind == WordToken {
+				words = append(words, tok.Text)
+			}
+		}
+		return words
+	}
+
+	text := "Cafe\u0301 don’t well\u2010known hy\u00adphen\u200bated \ufb01ne"
+	s.Equal([]string{"Café", "don't", "well-known", "hyphenated", "ﬁne"}, words(text, Options{}))
+	s.Equal([]string{"Café", "don't", "well-known", "hyphenated", "fine"}, words(text, Options{Normalisation: NormaliseNFKC}))
+	s.Equal([]string{"Cafe\u0301", "don’t", "well", "known", "hyphenated", "ﬁne"}, words(text, Options{Normalisation: KeepUnnormalised}))
+
+	// spans still cover the text as written
+	toks := tokens(Tokenize(strings.NewReader("Cafe\u0301 ok")))
+	s.Equal(Token{Kind: WordToken, Text: "Café", Start: 0, End: 6, Line: 1, Column: 1}, toks[0])
+	s.Equal(7, toks[2].Start)
+}
+
+func (s *TokenizeSuite) TestNormalisedTextsMatch() {
+	plain := "The café’s “naïve” owner said it's a well-known place. Don't go there!"
+	for _, text := range []string{
+		"The cafe\u0301's \"nai\u0308ve\" owner said it’s a well\u2011known place. Don’t go there!",
+		"The\u00a0café’s “naïve” ow\u00adner said it's a well-known\u200d place.\u00a0Don't go there!",
+	} {
+		a, _ := AnalyseWithOptions(strings.NewReader(plain), Options{WordFrequencies: true})
+		b, _ := AnalyseWithOptions(strings.NewReader(text), Options{WordFrequencies: true})
+		s.Equal(a.Words, b.Words)
+		s.Equal(a.Letters, b.Letters)
+		s.Equal(a.Syllables, b.Syllables)
+		s.Equal(a.WordFrequencies, b.WordFrequencies)
+		s.Equal(a.FleschKincaidGradeLevel(), b.FleschKincaidGradeLevel())
+	}
+}
+
+func (s *TokenizeSuite) TestTokenKindString() {
+	s.Equal("word", WordToken.String())
+	s.Equal("sentence-end", SentenceEndToken.String())
+	s.Equal("unknown", TokenKind(-1).String())
+}
+
+func TestTokenize(t *testing.T) {
+	suite.Run(t, new(TokenizeSuite))
+}
+
+func BenchmarkTokenize(b *testing.B) {
+	b.SetBytes(int64(len(benchCorpus)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		t := Tokenize(bytes.NewReader(benchCorpus))
+		for t.Next() {
+		}
+		if err := t.Err(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package textstats
+
+import (
+	"errors"
+	"io"
+)
+
+// WindowUnit is what the size of an analysis window is measured in
+type WindowUnit int
+
+// Units windows can be measured in
+const (
+	// WordWindows are measured in words
+	WordWindows WindowUnit = iota
+
+	// SentenceWindows are measured in sentences
+	SentenceWindows
+)
+
+// WindowOptions controls how a text is split into windows by AnalyseWindows
+type WindowOptions struct {
+	Unit WindowUnit
+
+	// Size is the number of words or sentences in each window
+	Size int
+
+	// Step is the number of words or sentences between the starts of
+	// successive windows. Windows overlap when it is less than Size. It
+	// defaults to Size.
+	Step int
+}
+
+// Window is the analysis of one part of a longer text
+type Window struct {
+	// Start and End are the byte offsets of the window in the text, from its
+	// first word to the end of its last word or punctuation mark
+	Start int
+	End   int
+
+	// Line is the one based line the window starts on
+	Line int
+
+	Results *Results
+}
+
+// errWindowSize is returned when a window has no size
+var errWindowSize = errors.New("textstats: window size must be positive")
+
+// openWindow is a window still being analysed
+type openWindow struct {
+	Window
+	units int
+}
+
+// AnalyseWindows scans a reader and analyses every window of the given size
+// within it, so the readability of different parts of a long text can be
+// compared. Windows are returned in order. The last windows may be short, but
+// only if they reach further into the text than the windows before them.
+//
+// Sentence indices in each window's Results count from the start of the
+// window, while byte offsets count from the start of the text.
+func AnalyseWindows(r io.Reader, w WindowOptions, opts Options) ([]Window, error) {
+	if w.Size <= 0 {
+		return nil, errWindowSize
+	}
+	if w.Step <= 0 {
+		w.Step = w.Size
+	}
+
+	// windows share one syllable cache, as they see the same words
+	var memo wordLookup = newWordMemo()
+	if opts.Cache != nil {
+		memo = opts.Cache
+	}
+
+	var windows []Window
+	var open []*openWindow
+	var units int
+	sentenceStart := true
+
+	closeWindow := func(ow *openWindow) {
+		endSentenceLength(ow.End, ow.Results)
+		windows = append(windows, ow.Window)
+	}
+
+	// closeFull closes the oldest open windows once they hold Size units
+	closeFull := func() {
+		for len(open) > 0 && open[0].units >= w.Size {
+			closeWindow(open[0])
+			open = open[1:]
+		}
+	}
+
+	t := TokenizeWithOptions(r, opts)
+	for t.Next() {
+		tok := t.Token()
+
+		// a unit is complete at every word, or at the end of every sentence
+		// with words in it
+		var unitEnd bool
+		switch tok.Kind {
+		case WordToken:
+			if w.Unit == WordWindows {
+				closeFull()
+			}
+
+			if (w.Unit == WordWindows || sentenceStart) && units%w.Step == 0 {
+				ow := &openWindow{Window: Window{
+					Start:   tok.Start,
+					Line:    tok.Line,
+					Results: newResults(opts),
+				}}
+				ow.Results.memo = memo
+				open = append(open, ow)
+			}
+
+			unitEnd = w.Unit == WordWindows
+			sentenceStart = false
+		case SentenceEndToken:
+			unitEnd = w.Unit == SentenceWindows && !sentenceStart
+			sentenceStart = true
+		}
+
+		for _, ow := range open {
+			analyseToken(tok, ow.Results)
+			if tok.Kind != SpaceToken && tok.Kind != SentenceEndToken {
+				ow.End = tok.End
+			}
+			if unitEnd {
+				ow.units++
+			}
+		}
+
+		if unitEnd {
+			units++
+			if w.Unit == SentenceWindows {
+				closeFull()
+			}
+		}
+	}
+
+	// short windows at the end of the text are only kept when they cover
+	// text the earlier windows don't
+	for _, ow := range open {
+		if len(windows) == 0 || ow.End > windows[len(windows)-1].End {
+			closeWindow(ow)
+		}
+	}
+
+	return windows, t.Err()
+}
@@ -0,0 +1,76 @@
+package textstats
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type WindowSuite struct {
+	suite.Suite
+}
+
+// spans returns the text covered by each window
+func spans(text string, windows []Window) []string {
+	var texts []string
+	for _, w := range windows {
+		texts = append(texts, text[w.Start:w.End])
+	}
+	return texts
+}
+
+func (s *WindowSuite) TestWordWindows() {
+	windows, err := AnalyseWindows(strings.NewReader(pasv), WindowOptions{Size: 10}, Options{})
+	s.NoError(err)
+	s.Equal([]string{
+		"The cake was eaten by the dog. It is red.",
+		"The letters were not written quickly, but they were quickly",
+		"written and sent! He is being followed.",
+	}, spans(pasv, windows))
+	s.Equal(10, windows[0].Results.Words)
+	s.Equal(2, windows[0].Results.Sentences)
+	s.Equal(7, windows[2].Results.Words)
+
+	// overlapping windows, where the last is dropped as the one before it
+	// already reaches the end of the text
+	windows, err = AnalyseWindows(strings.NewReader(pasv), WindowOptions{Size: 10, Step: 5}, Options{})
+	s.NoError(err)
+	s.Len(windows, 5)
+	s.Equal("the dog. It is red. The letters were not written", pasv[windows[1].Start:windows[1].End])
+	s.Equal(len(pasv), windows[4].End)
+}
+
+func (s *WindowSuite) TestSentenceWindows() {
+	windows, err := AnalyseWindows(strings.NewReader(pasv), WindowOptions{Unit: SentenceWindows, Size: 2, Step: 1}, Options{})
+	s.NoError(err)
+	s.Equal([]string{
+		"The cake was eaten by the dog. It is red.",
+		"It is red. The letters were not written quickly, but they were quickly written and sent!",
+		"The letters were not written quickly, but they were quickly written and sent! He is being followed.",
+	}, spans(pasv, windows))
+	s.Equal(2, windows[2].Results.PassiveSentences)
+	s.Equal(map[int]int{4: 1, 13: 1}, windows[2].Results.SentenceCountPerWordCount)
+}
+
+func (s *WindowSuite) TestWindowLines() {
+	windows, err := AnalyseWindows(strings.NewReader("One two.\nThree four.\n\nFive."), WindowOptions{Unit: SentenceWindows, Size: 1}, Options{})
+	s.NoError(err)
+	s.Require().Len(windows, 3)
+	s.Equal(1, windows[0].Line)
+	s.Equal(2, windows[1].Line)
+	s.Equal(4, windows[2].Line)
+}
+
+func (s *WindowSuite) TestWindowSize() {
+	_, err := AnalyseWindows(strings.NewReader(pasv), WindowOptions{}, Options{})
+	s.Error(err)
+
+	windows, err := AnalyseWindows(strings.NewReader(""), WindowOptions{Size: 10}, Options{})
+	s.NoError(err)
+	s.Empty(windows)
+}
+
+func TestAnalyseWindows(t *testing.T) {
+	suite.Run(t, new(WindowSuite))
+}
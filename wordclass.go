@@ -0,0 +1,156 @@
+package textstats
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// WordClass is a set of flags describing what kind of word a word is. A word
+// with no flags set is a common word.
+type WordClass uint8
+
+// Word classes a word may belong to. A word may belong to more than one, for
+// example a hyphenated proper noun.
+const (
+	// ProperNoun is a capitalised word that isn't at the start of a
+	// sentence, or one at the start of a sentence that has already been seen
+	// capitalised elsewhere
+	ProperNoun WordClass = 1 << iota
+
+	// Acronym is a word of two or more letters, all of them upper case
+	Acronym
+
+	// Number is a word containing digits
+	Number
+
+	// SentenceInitial is the first word of a sentence
+	SentenceInitial
+
+	// Compound is a hyphenated word
+	Compound
+
+	// CJKCharacter is a single Chinese character or Japanese kanji or kana,
+	// each of which counts as a word with one syllable
+	CJKCharacter
+)
+
+var wordClassNames = [...]string{
+	"proper-noun",
+	"acronym",
+	"number",
+	"sentence-initial",
+	"compound",
+	"cjk-character",
+}
+
+// String returns the names of the classes in c joined by "|", or "common"
+// for a common word
+func (c WordClass) String() string {
+	var names []string
+	for i, name := range wordClassNames {
+		if c&(1<<uint(i)) != 0 {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return "common"
+	}
+	return strings.Join(names, "|")
+}
+
+// WordsOfClass returns the number of words in the text belonging to every
+// class in c. WordsOfClass(0) returns the number of words.
+func (r *Results) WordsOfClass(c WordClass) int {
+	var total int
+	for class, counts := range r.WordCountPerSyllableCountByClass {
+		if class&c != c {
+			continue
+		}
+		for _, wCount := range counts {
+			total += wCount
+		}
+	}
+	return total
+}
+
+// CommonWords returns the number of words in the text that belong to no word
+// class
+func (r *Results) CommonWords() int {
+	var total int
+	for _, wCount := range r.WordCountPerSyllableCountByClass[0] {
+		total += wCount
+	}
+	return total
+}
+
+// WordsWithAtLeastNSyllablesExcluding returns the number of words with at
+// least N syllables in the text, leaving out words belonging to any of the
+// classes in exclude
+func (r *Results) WordsWithAtLeastNSyllablesExcluding(n int, exclude WordClass) int {
+	var total int
+	for class, counts := range r.WordCountPerSyllableCountByClass {
+		if class&exclude != 0 {
+			continue
+		}
+		for sCount, wCount := range counts {
+			if sCount >= n {
+				total += wCount
+			}
+		}
+	}
+	return total
+}
+
+// classifyWord works out which classes a word belongs to. Capitalised words at
+// the start of a sentence are only proper nouns if they have been seen
+// capitalised in the middle of a sentence before.
+func classifyWord(word string, sentenceInitial bool, res *Results) WordClass {
+	var class WordClass
+	if sentenceInitial {
+		class |= SentenceInitial
+	}
+
+	if r, size := utf8.DecodeRuneInString(word); size == len(word) && isCJK(r) {
+		return class | CJKCharacter
+	}
+
+	var letters, upper int
+	for _, l := range word {
+		switch {
+		case unicode.IsDigit(l):
+			class |= Number
+		case l == '-':
+			class |= Compound
+		case unicode.IsLetter(l):
+			letters++
+			if unicode.IsUpper(l) {
+				upper++
+			}
+		}
+	}
+
+	if letters >= 2 && upper == letters {
+		return class | Acronym
+	}
+
+	if first, _ := utf8.DecodeRuneInString(word); !unicode.IsUpper(first) {
+		return class
+	}
+
+	// the pronoun I and its contractions are always capitalised
+	if word == "I" || strings.HasPrefix(word, "I'") || strings.HasPrefix(word, "I’") {
+		return class
+	}
+
+	if !sentenceInitial {
+		res.properNouns[word] = struct{}{}
+		return class | ProperNoun
+	}
+
+	if _, ok := res.properNouns[word]; ok {
+		return class | ProperNoun
+	}
+
+	return class
+}
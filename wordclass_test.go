@@ -0,0 +1,53 @@
+package textstats
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type WordClassSuite struct {
+	suite.Suite
+}
+
+func (s *WordClassSuite) TestClassifyWord() {
+	res := &Results{properNouns: make(map[string]struct{})}
+
+	s.Equal(WordClass(0), classifyWord("excellent", false, res))
+	s.Equal(SentenceInitial, classifyWord("Unfortunately", true, res))
+	s.Equal(ProperNoun, classifyWord("London", false, res))
+	s.Equal(ProperNoun|SentenceInitial, classifyWord("London", true, res))
+	s.Equal(Acronym, classifyWord("NASA", false, res))
+	s.Equal(WordClass(0), classifyWord("I", false, res))
+	s.Equal(Number, classifyWord("2024", false, res))
+	s.Equal(Compound, classifyWord("well-known", false, res))
+	s.Equal(Acronym|Number|Compound, classifyWord("COVID-19", false, res))
+}
+
+func (s *WordClassSuite) TestWordClassString() {
+	s.Equal("common", WordClass(0).String())
+	s.Equal("proper-noun|sentence-initial", (ProperNoun | SentenceInitial).String())
+}
+
+func (s *WordClassSuite) TestWordsOfClass() {
+	res, _ := Analyse(strings.NewReader("Unfortunately London is big. Then NASA went to London. London is far."))
+	s.Equal(12, res.WordsOfClass(0))
+	s.Equal(3, res.WordsOfClass(SentenceInitial))
+	s.Equal(3, res.WordsOfClass(ProperNoun))
+	s.Equal(1, res.WordsOfClass(ProperNoun|SentenceInitial))
+	s.Equal(1, res.WordsOfClass(Acronym))
+	s.Equal(6, res.CommonWords())
+}
+
+func (s *WordClassSuite) TestSentenceInitialWordsAreNotProperNouns() {
+	// "Unfortunately" starts a sentence, so it counts as a complex word
+	res, _ := Analyse(strings.NewReader("Unfortunately we left. Then Elizabeth arrived."))
+	s.Equal(1, res.WordsWithAtLeastNSyllables(3, false))
+	s.Equal(2, res.WordsWithAtLeastNSyllables(3, true))
+	s.Equal(0, res.WordsWithAtLeastNSyllablesExcluding(3, ProperNoun|SentenceInitial))
+}
+
+func TestWordClassMethods(t *testing.T) {
+	suite.Run(t, new(WordClassSuite))
+}